@@ -0,0 +1,40 @@
+package binding_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/logger"
+)
+
+type MethodNamesStruct struct{}
+
+func (m *MethodNamesStruct) Greet(name string) string { return "Hello " + name }
+func (m *MethodNamesStruct) Add(a, b int) int         { return a + b }
+
+func TestDB_MethodNames(t *testing.T) {
+	testLogger := &logger.Logger{}
+	b := binding.NewBindings(testLogger, []interface{}{&MethodNamesStruct{}}, nil, false, nil)
+
+	require.Equal(t, []string{
+		"binding_test.MethodNamesStruct.Add",
+		"binding_test.MethodNamesStruct.Greet",
+	}, b.DB().MethodNames())
+}
+
+func TestDB_GetObfuscatedMethodName(t *testing.T) {
+	testLogger := &logger.Logger{}
+	b := binding.NewBindings(testLogger, []interface{}{&MethodNamesStruct{}}, nil, false, nil)
+
+	for name, id := range b.DB().UpdateObfuscatedCallMap() {
+		got, ok := b.DB().GetObfuscatedMethodName(id)
+		require.True(t, ok)
+		require.Equal(t, name, got)
+	}
+
+	_, ok := b.DB().GetObfuscatedMethodName(-1)
+	require.False(t, ok)
+	_, ok = b.DB().GetObfuscatedMethodName(2)
+	require.False(t, ok)
+}
@@ -0,0 +1,65 @@
+package binding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/logger"
+)
+
+type ParseArgsStruct struct{}
+
+func (p *ParseArgsStruct) Repeat(text string, count int) string { return "" }
+
+func TestBoundMethod_ParseArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []interface{}
+		wantErr string
+	}{
+		{
+			name: "valid arguments",
+			args: []string{`"hello"`, `3`},
+			want: []interface{}{"hello", 3},
+		},
+		{
+			name:    "missing argument",
+			args:    []string{`"hello"`},
+			wantErr: "received 1 arguments to method 'binding_test.ParseArgsStruct.Repeat', expected 2",
+		},
+		{
+			name:    "extra argument",
+			args:    []string{`"hello"`, `3`, `true`},
+			wantErr: "received 3 arguments to method 'binding_test.ParseArgsStruct.Repeat', expected 2",
+		},
+		{
+			name:    "wrong type",
+			args:    []string{`"hello"`, `"3"`},
+			wantErr: "json: cannot unmarshal string into Go value of type int",
+		},
+	}
+
+	testLogger := &logger.Logger{}
+	b := binding.NewBindings(testLogger, []interface{}{&ParseArgsStruct{}}, nil, false, nil)
+	method := b.DB().GetMethod("binding_test.ParseArgsStruct.Repeat")
+	require.NotNil(t, method)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := make([]json.RawMessage, len(tt.args))
+			for i, arg := range tt.args {
+				args[i] = json.RawMessage(arg)
+			}
+			got, err := method.ParseArgs(args)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
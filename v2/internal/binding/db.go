@@ -2,6 +2,7 @@ package binding
 
 import (
 	"encoding/json"
+	"sort"
 	"sync"
 	"unsafe"
 )
@@ -76,6 +77,19 @@ func (d *DB) GetObfuscatedMethod(id int) *BoundMethod {
 	return d.obfuscatedMethodArray[id].method
 }
 
+// GetObfuscatedMethodName returns the fully qualified name of the method with the given obfuscated ID
+func (d *DB) GetObfuscatedMethodName(id int) (string, bool) {
+	// Lock the db whilst processing and unlock on return
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if id < 0 || len(d.obfuscatedMethodArray) <= id {
+		return "", false
+	}
+
+	return d.obfuscatedMethodArray[id].methodName, true
+}
+
 // AddMethod adds the given method definition to the db using the given qualified path: packageName.structName.methodName
 func (d *DB) AddMethod(packageName string, structName string, methodName string, methodDefinition *BoundMethod) {
 	// Lock the db whilst processing and unlock on return
@@ -107,6 +121,20 @@ func (d *DB) AddMethod(packageName string, structName string, methodName string,
 	d.obfuscatedMethodArray = append(d.obfuscatedMethodArray, &ObfuscatedMethod{method: methodDefinition, methodName: key})
 }
 
+// MethodNames returns the sorted, fully qualified names of all bound methods
+func (d *DB) MethodNames() []string {
+	// Lock the db whilst processing and unlock on return
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	result := make([]string, 0, len(d.methodMap))
+	for name := range d.methodMap {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // ToJSON converts the method map to JSON
 func (d *DB) ToJSON() (string, error) {
 	// Lock the db whilst processing and unlock on return
@@ -0,0 +1,45 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"strings"
+)
+
+// SetBindingAuthorizer sets a function which is called before a browser calls
+// a bound method, with the fully qualified name of the method and the JSON
+// encoded arguments. If it returns an error, the method is not called and the
+// call is rejected with the error. Calls made by the runtime itself are not
+// authorized. The client ID is empty for calls made over HTTP.
+// It must be called before Run.
+func (d *DevWebServer) SetBindingAuthorizer(authorizer func(clientID string, method string, args []byte) error) {
+	d.bindingAuthorizer = authorizer
+}
+
+// authorizeCall checks a call message against the binding authorizer. If the
+// call is denied, it returns the callback message rejecting it.
+func (d *DevWebServer) authorizeCall(clientID string, userID string, msg string) (string, bool) {
+	if d.bindingAuthorizer == nil {
+		return "", true
+	}
+
+	// Leave reporting malformed calls to the dispatcher
+	call, method, ok := d.parseCall(msg)
+	if !ok || strings.HasPrefix(method, systemCallPrefix) {
+		return "", true
+	}
+
+	err := d.bindingAuthorizer(clientID, method, call.Args)
+	if err == nil {
+		return "", true
+	}
+
+	if userID != "" {
+		d.logger.Warning("[DevWebServer] Denied call of '%s' by client '%s' (user '%s'): %s", method, clientID, userID, err.Error())
+	} else {
+		d.logger.Warning("[DevWebServer] Denied call of '%s' by client '%s': %s", method, clientID, err.Error())
+	}
+
+	return d.errorCallback(call.CallbackID, err), false
+}
@@ -0,0 +1,40 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+// EventBackpressure is emitted to the Go listeners whenever a message is
+// dropped because the outbound queue of a client is full
+const EventBackpressure = "wails:backpressure"
+
+// Backpressure is the data of an EventBackpressure event
+type Backpressure struct {
+	Client string `json:"client"`
+	// Type is the type of the dropped message, its first character for text
+	// messages or "binary"
+	Type string `json:"type"`
+	// Dropped is the number of messages dropped for all clients so far
+	Dropped int64 `json:"dropped"`
+}
+
+// DroppedMessages returns the number of messages dropped so far because the
+// outbound queue of a client was full
+func (d *DevWebServer) DroppedMessages() int64 {
+	return d.droppedMessages.Load()
+}
+
+// messageDropped counts a message dropped for the client and reports it. The
+// event only goes to the Go listeners, as the browsers may be what can't keep up.
+func (d *DevWebServer) messageDropped(client *websocketClient, message interface{}) {
+	dropped := d.droppedMessages.Add(1)
+	messageType := "binary"
+	if text, ok := message.(string); ok && text != "" {
+		messageType = text[:1]
+	}
+	d.logger.Warning("[DevWebServer] Outbound queue of client %s is full, dropping '%s' message", client.id, messageType)
+	go d.emitBackend(EventBackpressure, &Backpressure{
+		Client:  client.id,
+		Type:    messageType,
+		Dropped: dropped,
+	})
+}
@@ -0,0 +1,95 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// bandwidthLimiter is a token bucket limiting the bytes written to a client
+// per second. The bucket holds up to a second worth of bytes, and a larger
+// message borrows from the future, delaying the following writes.
+type bandwidthLimiter struct {
+	lock sync.Mutex
+	// rate is the number of bytes per second, 0 means unlimited
+	rate   int
+	tokens float64
+	last   time.Time
+}
+
+func (l *bandwidthLimiter) setRate(bytesPerSecond int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.rate = bytesPerSecond
+	l.tokens = float64(bytesPerSecond)
+	l.last = time.Now()
+}
+
+// reserve takes size bytes from the bucket and returns how long the write has
+// to wait for them
+func (l *bandwidthLimiter) reserve(size int) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+	if l.tokens > float64(l.rate) {
+		l.tokens = float64(l.rate)
+	}
+	l.last = now
+
+	l.tokens -= float64(size)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+}
+
+// wait blocks until size bytes may be written or ctx is done
+func (l *bandwidthLimiter) wait(ctx context.Context, size int) error {
+	delay := l.reserve(size)
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// messageSize returns the number of bytes of a message written to a client
+func messageSize(message interface{}) int {
+	switch message := message.(type) {
+	case string:
+		return len(message)
+	case []byte:
+		return len(message)
+	}
+	return 0
+}
+
+// SetPerConnectionBandwidth limits the bytes per second written to the
+// browser. Messages over the limit wait in the client's queue, so once it is
+// full the backpressure policy applies. A limit of 0 removes it. It returns an
+// error if the client isn't connected.
+func (d *DevWebServer) SetPerConnectionBandwidth(clientID string, bytesPerSecond int) error {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return fmt.Errorf("client %s is not connected", clientID)
+	}
+	client.bandwidth.setRate(bytesPerSecond)
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBandwidthLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  int
+		sizes []int
+		// want is the delay of the last reservation, with a tolerance for the
+		// time passing between the reservations
+		want time.Duration
+	}{
+		{"unlimited", 0, []int{1 << 20}, 0},
+		{"within-rate", 1000, []int{400, 600}, 0},
+		{"over-rate", 1000, []int{1000, 500}, 500 * time.Millisecond},
+		{"larger-than-bucket", 1000, []int{3000}, 2 * time.Second},
+		{"borrowed", 1000, []int{2000, 1000}, 2 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var limiter bandwidthLimiter
+			limiter.setRate(tt.rate)
+			var got time.Duration
+			for _, size := range tt.sizes {
+				got = limiter.reserve(size)
+			}
+			if got > tt.want || got < tt.want-50*time.Millisecond {
+				t.Errorf("reserve() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBandwidthLimiterWait(t *testing.T) {
+	var limiter bandwidthLimiter
+	limiter.setRate(1000)
+	if err := limiter.wait(context.Background(), 1000); err != nil {
+		t.Fatalf("wait() error = '%v'", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.wait(ctx, 1000); err != context.Canceled {
+		t.Errorf("wait() error = '%v', want '%v'", err, context.Canceled)
+	}
+}
+
+func TestMessageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		message interface{}
+		want    int
+	}{
+		{"string", "reload", 6},
+		{"bytes", []byte{1, 2, 3}, 3},
+		{"other", 42, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := messageSize(tt.message); got != tt.want {
+				t.Errorf("messageSize() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// SetBasePath sets the path prefix the frontend is served under, e.g. "/app"
+// when a reverse proxy forwards "/app/*" to the dev server with the prefix
+// stripped. The index.html gets a matching <base> element, the runtime
+// scripts are loaded from below the prefix and the runtime connects to the
+// IPC route below it. It must be called before Run.
+func (d *DevWebServer) SetBasePath(prefix string) {
+	d.basePath = strings.TrimSuffix(prefix, "/")
+}
+
+// ipcPath returns the path the runtime connects to for IPC
+func (d *DevWebServer) ipcPath() string {
+	return d.basePath + d.routes.IPC
+}
+
+// buildIndexTransform returns the transformation applied to the index.html for
+// every request, or nil if there is none.
+func (d *DevWebServer) buildIndexTransform() func(indexHTML []byte, req *http.Request) []byte {
+	basePath, criticalCSS, transform := d.basePath, d.criticalCSS, d.indexTransform
+	if basePath == "" && criticalCSS == "" && transform == nil {
+		return nil
+	}
+
+	return func(indexHTML []byte, req *http.Request) []byte {
+		if basePath != "" {
+			rewritten, err := rewriteBasePath(indexHTML, basePath)
+			if err != nil {
+				d.logger.Error("Unable to apply the base path to index.html: %s", err.Error())
+			} else {
+				indexHTML = rewritten
+			}
+		}
+		if criticalCSS != "" {
+			inlined, err := inlineCriticalCSS(indexHTML, criticalCSS)
+			if err != nil {
+				d.logger.Error("Unable to inline the critical CSS in index.html: %s", err.Error())
+			} else {
+				indexHTML = inlined
+			}
+		}
+		if transform != nil {
+			indexHTML = []byte(transform(string(indexHTML), req))
+		}
+		return indexHTML
+	}
+}
+
+// rewriteBasePath adds a <base> element for the base path to the head of the
+// HTML, unless it has one, and prefixes the paths of the runtime scripts.
+func rewriteBasePath(indexHTML []byte, basePath string) ([]byte, error) {
+	document, err := html.Parse(bytes.NewReader(indexHTML))
+	if err != nil {
+		return nil, err
+	}
+
+	var head *html.Node
+	hasBase := false
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode {
+			switch node.DataAtom {
+			case atom.Head:
+				if head == nil {
+					head = node
+				}
+			case atom.Base:
+				hasBase = true
+			case atom.Script:
+				for i, attr := range node.Attr {
+					if attr.Key == "src" && strings.HasPrefix(attr.Val, "/wails/") {
+						node.Attr[i].Val = basePath + attr.Val
+					}
+				}
+			}
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(document)
+
+	if head != nil && !hasBase {
+		base := &html.Node{
+			Type:     html.ElementNode,
+			Data:     "base",
+			DataAtom: atom.Base,
+			Attr:     []html.Attribute{{Key: "href", Val: basePath + "/"}},
+		}
+		head.InsertBefore(base, head.FirstChild)
+	}
+
+	var buffer bytes.Buffer
+	if err := html.Render(&buffer, document); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,48 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"testing"
+)
+
+func TestRewriteBasePath(t *testing.T) {
+	tests := []struct {
+		name      string
+		indexHTML string
+		want      string
+	}{
+		{
+			"adds-base",
+			`<html><head><title>App</title></head><body></body></html>`,
+			`<html><head><base href="/app/"/><title>App</title></head><body></body></html>`,
+		},
+		{
+			"keeps-base",
+			`<html><head><base href="/other/"/></head><body></body></html>`,
+			`<html><head><base href="/other/"/></head><body></body></html>`,
+		},
+		{
+			"runtime-scripts",
+			`<html><head><script src="/wails/runtime.js"></script><script src="/main.js"></script></head><body></body></html>`,
+			`<html><head><base href="/app/"/><script src="/app/wails/runtime.js"></script><script src="/main.js"></script></head><body></body></html>`,
+		},
+		{
+			"no-head",
+			`<body><p>App</p></body>`,
+			`<html><head><base href="/app/"/></head><body><p>App</p></body></html>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rewriteBasePath([]byte(tt.indexHTML), "/app")
+			if err != nil {
+				t.Fatalf("rewriteBasePath() error = '%v'", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("rewriteBasePath() = '%s', want '%s'", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+)
+
+// maxSafeInteger is the largest integer JavaScript numbers represent exactly
+const maxSafeInteger = 1<<53 - 1
+
+// SetBigNumberMode sets whether integers in event data that JavaScript can't
+// represent exactly, beyond ±(2^53-1), are sent as strings. Without it, large
+// int64 or uint64 values such as IDs or nanosecond timestamps are silently
+// rounded by the browser. The frontend receives such values as decimal
+// strings and can convert them with BigInt(value). Smaller integers are still
+// sent as numbers. It must be called before Run.
+func (d *DevWebServer) SetBigNumberMode(enabled bool) {
+	d.bigNumberMode = enabled
+}
+
+// quoteBigNumbers re-encodes JSON with all integers beyond the safe range of
+// JavaScript numbers as strings
+func quoteBigNumbers(payload []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(quoteBigNumbersIn(value))
+}
+
+func quoteBigNumbersIn(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = quoteBigNumbersIn(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = quoteBigNumbersIn(item)
+		}
+	case json.Number:
+		if isUnsafeInteger(value) {
+			return value.String()
+		}
+	}
+	return value
+}
+
+// isUnsafeInteger returns true if the number is an integer JavaScript can't
+// represent exactly
+func isUnsafeInteger(number json.Number) bool {
+	text := number.String()
+	if strings.ContainsAny(text, ".eE") {
+		return false
+	}
+	integer, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Abs(integer).Cmp(big.NewInt(maxSafeInteger)) > 0
+}
@@ -0,0 +1,38 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"testing"
+)
+
+func TestQuoteBigNumbers(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{"small", `{"id":42}`, `{"id":42}`, false},
+		{"max-safe", `[9007199254740991,-9007199254740991]`, `[9007199254740991,-9007199254740991]`, false},
+		{"beyond-safe", `[9007199254740992,-9007199254740992]`, `["9007199254740992","-9007199254740992"]`, false},
+		{"uint64", `{"id":18446744073709551615}`, `{"id":"18446744073709551615"}`, false},
+		{"nested", `{"a":[{"b":1234567890123456789}],"c":"text"}`, `{"a":[{"b":"1234567890123456789"}],"c":"text"}`, false},
+		{"float", `[1e300,12345678901234567890.5]`, `[1e300,12345678901234567890.5]`, false},
+		{"scalar", `1234567890123456789`, `"1234567890123456789"`, false},
+		{"null", `null`, `null`, false},
+		{"invalid", `{"id":`, ``, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := quoteBigNumbers([]byte(tt.payload))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("quoteBigNumbers() error = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("quoteBigNumbers() = '%s', want '%s'", got, tt.want)
+			}
+		})
+	}
+}
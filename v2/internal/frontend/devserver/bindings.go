@@ -0,0 +1,36 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RequireBindings sets the fully qualified names of the bound methods the
+// frontend relies on, e.g. "main.App.Greet". Run fails with an error listing
+// the missing ones if any of them isn't bound, instead of the calls failing
+// when they are first made. It must be called before Run.
+func (d *DevWebServer) RequireBindings(names []string) {
+	d.requiredBindings = append(d.requiredBindings, names...)
+}
+
+// checkRequiredBindings returns an error if a binding set with RequireBindings isn't bound
+func (d *DevWebServer) checkRequiredBindings() error {
+	if len(d.requiredBindings) == 0 {
+		return nil
+	}
+
+	db := d.appBindings.DB()
+	var missing []string
+	for _, name := range d.requiredBindings {
+		if db.GetMethod(name) == nil {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("required bindings are not bound: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
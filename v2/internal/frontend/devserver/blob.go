@@ -0,0 +1,44 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// blobMagic starts the header of a binary frame carrying a blob. It has to
+// match `blobMagic` in runtime/dev/main.js.
+const blobMagic = "WLBB"
+
+// SendBlob sends the data to every connected browser as a binary frame. The
+// runtime passes it as an ArrayBuffer to the event listeners registered for
+// channel with runtime.EventsOn, which avoids encoding it as base64 in JSON.
+//
+// The frame starts with a header of the magic bytes "WLBB", the length of the
+// channel name as a big endian uint16 and the channel name.
+func (d *DevWebServer) SendBlob(channel string, data []byte) error {
+	if channel == "" {
+		return errors.New("blob channel must not be empty")
+	}
+	if len(channel) > math.MaxUint16 {
+		return fmt.Errorf("blob channel name is %d bytes, the maximum is %d bytes", len(channel), math.MaxUint16)
+	}
+
+	frame := make([]byte, 0, len(blobMagic)+2+len(channel)+len(data))
+	frame = append(frame, blobMagic...)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(channel)))
+	frame = append(frame, channel...)
+	frame = append(frame, data...)
+
+	var errs []error
+	for _, client := range d.clients() {
+		if err := client.send(frame); err != nil {
+			errs = append(errs, fmt.Errorf("client %s: %w", client.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
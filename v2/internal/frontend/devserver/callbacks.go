@@ -0,0 +1,77 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/json"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/dispatcher"
+)
+
+// systemCallPrefix is the prefix of the calls made by the runtime itself
+const systemCallPrefix = ":wails:"
+
+// bindingCall is the part of a call message the dev server needs to know about
+type bindingCall struct {
+	Name       string          `json:"name"`
+	ID         *int            `json:"id"`
+	Args       json.RawMessage `json:"args"`
+	CallbackID string          `json:"callbackID"`
+}
+
+// parseCall decodes a call message and resolves the fully qualified name of
+// the called method. It returns false if the message isn't a valid call.
+func (d *DevWebServer) parseCall(msg string) (bindingCall, string, bool) {
+	var call bindingCall
+	if msg == "" || (msg[0] != 'C' && msg[0] != 'c') {
+		return call, "", false
+	}
+	if err := json.Unmarshal([]byte(msg[1:]), &call); err != nil {
+		return call, "", false
+	}
+	if msg[0] == 'C' {
+		return call, call.Name, true
+	}
+	if call.ID == nil {
+		return call, "", false
+	}
+	method, ok := d.appBindings.DB().GetObfuscatedMethodName(*call.ID)
+	return call, method, ok
+}
+
+// errorCallback returns the callback message rejecting the call with the
+// given callback ID, or an empty string if there is no callback to reject.
+func (d *DevWebServer) errorCallback(callbackID string, err error) string {
+	if callbackID == "" {
+		return ""
+	}
+	callback, merr := json.Marshal(&dispatcher.CallbackMessage{
+		CallbackID: callbackID,
+		Err:        err.Error(),
+	})
+	if merr != nil {
+		d.logger.Error(merr.Error())
+		return ""
+	}
+	return "c" + string(callback)
+}
+
+// dispatchErrorCallback returns the callback message for a message the
+// dispatcher failed to process, so the call of the browser doesn't hang.
+// result is what the dispatcher returned along with the error.
+func (d *DevWebServer) dispatchErrorCallback(msg string, result string, err error) string {
+	if result != "" {
+		// Unlike successful results, the dispatcher returns error callbacks
+		// without the message type
+		return "c" + result
+	}
+	if msg == "" || (msg[0] != 'C' && msg[0] != 'c') {
+		return ""
+	}
+	var call bindingCall
+	if json.Unmarshal([]byte(msg[1:]), &call) != nil {
+		return ""
+	}
+	return d.errorCallback(call.CallbackID, err)
+}
@@ -0,0 +1,240 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// outboundQueueSize is the number of messages queued for a client before the
+// backpressure policy applies
+const outboundQueueSize = 256
+
+// BackpressurePolicy decides what happens to a message sent to a client whose
+// outbound queue is full
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock makes the sender wait until the queue has room or the
+	// client is disconnected, which happens once a write has timed out
+	BackpressureBlock BackpressurePolicy = iota
+	// BackpressureDrop drops the message and returns ErrQueueFull
+	BackpressureDrop
+)
+
+// ErrQueueFull is returned when a message is dropped because the outbound
+// queue of a client is full
+var ErrQueueFull = errors.New("outbound queue is full")
+
+// websocketClient is a browser connected to the IPC websocket
+type websocketClient struct {
+	id   string
+	conn *websocket.Conn
+
+	// userID is the user resolved by the upgrade authenticator, if any
+	userID     string
+	remoteAddr string
+	// acceptsGzip is set once the runtime announced it can decompress gzip compressed messages
+	acceptsGzip atomic.Bool
+	// group is the connection group the client belongs to, if any
+	group string
+	// version is the protocol version reported by the runtime
+	version string
+
+	// ctx is cancelled as soon as the client disconnects
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// queue holds the messages waiting to be written by writeMessages
+	queue   chan interface{}
+	policy  BackpressurePolicy
+	pending *pendingSends
+	// priorityQueue holds the control messages, which writeMessages writes
+	// before the messages of queue
+	priorityQueue chan interface{}
+	// onDrop is called with every message dropped because the queue was full
+	onDrop func(message interface{})
+	// lock guards closed against messages being queued after the writer stopped
+	lock   sync.RWMutex
+	closed bool
+
+	// ready is closed once the browser reported its DOM is ready
+	ready     chan struct{}
+	readyOnce sync.Once
+
+	bandwidth bandwidthLimiter
+	bytesSent atomic.Int64
+
+	// recent keeps the last messages written to the client, if enabled
+	recent *recentMessages
+
+	// meta is the metadata attached to the client by the app
+	meta     map[string]interface{}
+	metaLock sync.RWMutex
+
+	connectedAt time.Time
+	// lastActive is the time of the last message received, in unix nanoseconds
+	lastActive atomic.Int64
+	// leaving is set once the browser announced it is disconnecting
+	leaving atomic.Bool
+
+	// cleanups are the functions registered with OnConnectionClose
+	cleanups    []func()
+	cleanedUp   bool
+	cleanupLock sync.Mutex
+}
+
+func newWebsocketClient(ctx context.Context, id string, conn *websocket.Conn, policy BackpressurePolicy, pending *pendingSends) *websocketClient {
+	result := &websocketClient{
+		id:            id,
+		conn:          conn,
+		queue:         make(chan interface{}, outboundQueueSize),
+		priorityQueue: make(chan interface{}, outboundQueueSize),
+		policy:        policy,
+		pending:       pending,
+		ready:         make(chan struct{}),
+
+		connectedAt: time.Now(),
+	}
+	result.ctx, result.cancel = context.WithCancel(ctx)
+	result.lastActive.Store(result.connectedAt.UnixNano())
+	return result
+}
+
+// send queues the message to be written to the client. Strings are sent as
+// text frames and byte slices as binary frames. Messages are written in the
+// order they were queued, after the control messages queued by sendPriority.
+func (c *websocketClient) send(message interface{}) error {
+	return c.enqueue(context.Background(), c.queue, message, c.policy == BackpressureBlock)
+}
+
+// sendWait queues the message like send, but always waits for room in the
+// queue, until ctx is done.
+func (c *websocketClient) sendWait(ctx context.Context, message interface{}) error {
+	return c.enqueue(ctx, c.queue, message, true)
+}
+
+// sendPriority queues a control message, like a call result, waiting for room
+// until ctx is done. Control messages are written in the order they were
+// queued, but ahead of the messages queued by send and sendWait which haven't
+// been written yet, so they may overtake events emitted before them.
+func (c *websocketClient) sendPriority(ctx context.Context, message interface{}) error {
+	return c.enqueue(ctx, c.priorityQueue, message, true)
+}
+
+func (c *websocketClient) enqueue(ctx context.Context, queue chan interface{}, message interface{}, block bool) error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if c.closed {
+		return fmt.Errorf("client %s is disconnected", c.id)
+	}
+
+	// The writer marks the message as done, so it has to be pending before it
+	// is queued
+	c.pending.add()
+	if !block {
+		select {
+		case queue <- message:
+			return nil
+		default:
+			c.pending.done(nil)
+			if c.onDrop != nil {
+				c.onDrop(message)
+			}
+			return ErrQueueFull
+		}
+	}
+	select {
+	case queue <- message:
+		return nil
+	case <-c.ctx.Done():
+		c.pending.done(nil)
+		return fmt.Errorf("client %s is disconnected", c.id)
+	case <-ctx.Done():
+		c.pending.done(nil)
+		return ctx.Err()
+	}
+}
+
+// stop marks the client as closed and discards the messages which haven't
+// been written yet
+func (c *websocketClient) stop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.closed = true
+	for {
+		select {
+		case <-c.priorityQueue:
+			c.pending.done(fmt.Errorf("client %s disconnected before the message was written", c.id))
+		case <-c.queue:
+			c.pending.done(fmt.Errorf("client %s disconnected before the message was written", c.id))
+		default:
+			return
+		}
+	}
+}
+
+// metadata returns a copy of the metadata attached to the client
+func (c *websocketClient) metadata() map[string]interface{} {
+	c.metaLock.RLock()
+	defer c.metaLock.RUnlock()
+	result := make(map[string]interface{}, len(c.meta))
+	for key, value := range c.meta {
+		result[key] = value
+	}
+	return result
+}
+
+// touch records that a message has been received from the client
+func (c *websocketClient) touch() {
+	c.lastActive.Store(time.Now().UnixNano())
+}
+
+// idleTime returns the time since the last message was received from the client
+func (c *websocketClient) idleTime() time.Duration {
+	return time.Since(time.Unix(0, c.lastActive.Load()))
+}
+
+func (c *websocketClient) setReady() {
+	c.readyOnce.Do(func() { close(c.ready) })
+}
+
+func (c *websocketClient) isReady() bool {
+	select {
+	case <-c.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// addCleanup registers a function to call when the client disconnects. It
+// returns false if the cleanup functions already ran.
+func (c *websocketClient) addCleanup(cleanup func()) bool {
+	c.cleanupLock.Lock()
+	defer c.cleanupLock.Unlock()
+	if c.cleanedUp {
+		return false
+	}
+	c.cleanups = append(c.cleanups, cleanup)
+	return true
+}
+
+// takeCleanups returns the registered cleanup functions, after which no more
+// can be added
+func (c *websocketClient) takeCleanups() []func() {
+	c.cleanupLock.Lock()
+	defer c.cleanupLock.Unlock()
+	c.cleanedUp = true
+	cleanups := c.cleanups
+	c.cleanups = nil
+	return cleanups
+}
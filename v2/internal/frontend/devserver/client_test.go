@@ -0,0 +1,39 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+)
+
+func TestSendPriority(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	conn := dialTestServer(t, url)
+	waitForClients(t, d, 1)
+	client := d.clients()[0]
+
+	// Slow down the writes, so the events are still queued when the call
+	// result is sent
+	client.bandwidth.setRate(1000)
+	const events = 10
+	payload := strings.Repeat("a", 500)
+	for i := 0; i < events; i++ {
+		d.Notify("bulk", payload)
+	}
+
+	call := `C{"name":"main.App.Greet","callbackID":"1"}`
+	if err := websocket.Message.Send(conn, call); err != nil {
+		t.Fatal(err)
+	}
+	got, before := receiveCallback(t, conn)
+	if want := "c" + call[1:]; got != want {
+		t.Errorf("received '%v', want '%v'", got, want)
+	}
+	if len(before) >= events {
+		t.Errorf("received %d events before the call result, want it to overtake the queued events", len(before))
+	}
+}
@@ -0,0 +1,47 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EventClientConfig is emitted with the new configuration whenever SetClientConfig is called
+const EventClientConfig = "wails:config"
+
+// SetClientConfig sets the configuration of the frontend, e.g. the base URL of
+// an API or feature flags. It is available as `window.wailsConfig` before the
+// app's scripts run. The config must be JSON serializable. Calling it again
+// updates `window.wailsConfig` in the connected browsers and emits an
+// EventClientConfig event with the new config.
+func (d *DevWebServer) SetClientConfig(config map[string]interface{}) error {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("invalid client config: %w", err)
+	}
+
+	d.clientConfigLock.Lock()
+	d.clientConfig = encoded
+	d.clientConfigLock.Unlock()
+
+	// The update takes the path of the event so that the browsers always
+	// receive it first, also while events are paused
+	update := outboundEvent{message: "config:" + string(encoded), websocketOnly: true}
+	if !d.bufferEvent(update) {
+		d.deliverEvent(update)
+	}
+	d.emit(EventClientConfig, config)
+	return nil
+}
+
+// encodedClientConfig returns the JSON encoded client config
+func (d *DevWebServer) encodedClientConfig() []byte {
+	d.clientConfigLock.RLock()
+	defer d.clientConfigLock.RUnlock()
+	if d.clientConfig == nil {
+		return []byte("{}")
+	}
+	return d.clientConfig
+}
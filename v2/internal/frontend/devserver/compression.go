@@ -0,0 +1,90 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// gzipMagic are the first bytes of any gzip stream
+const gzipMagic = "\x1f\x8b"
+
+// maxDecompressedMessage limits the size of a decompressed inbound message to
+// protect against decompression bombs
+const maxDecompressedMessage = 32 << 20
+
+// compressionGzip is the message with which the runtime announces it can
+// decompress gzip compressed messages
+const compressionGzip = "compression:gzip"
+
+// compressedMagic prefixes the gzip stream of a compressed event, so the
+// runtime doesn't mistake binary frames sent by the app for one
+const compressedMagic = "WLGZ"
+
+// SetInboundDecompression sets whether gzip compressed messages sent by the
+// browsers are decompressed before they are dispatched. This allows clients
+// which can't negotiate compression on the transport to send large payloads
+// as gzip compressed binary frames.
+func (d *DevWebServer) SetInboundDecompression(enabled bool) {
+	d.inboundDecompression = enabled
+}
+
+// decompressMessage returns the decompressed message if it is gzip compressed
+// and inbound decompression is enabled, otherwise msg is returned unchanged.
+func (d *DevWebServer) decompressMessage(msg string) (string, error) {
+	if !d.inboundDecompression || !strings.HasPrefix(msg, gzipMagic) {
+		return msg, nil
+	}
+
+	reader, err := gzip.NewReader(strings.NewReader(msg))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	var result bytes.Buffer
+	n, err := io.Copy(&result, io.LimitReader(reader, maxDecompressedMessage+1))
+	if err != nil {
+		return "", err
+	}
+	if n > maxDecompressedMessage {
+		return "", fmt.Errorf("decompressed message exceeds %d bytes", maxDecompressedMessage)
+	}
+	return result.String(), nil
+}
+
+// SetEventCompression sets the size in bytes above which events are sent gzip
+// compressed, as binary frames, to the browsers whose runtime supports it.
+// Smaller events are sent uncompressed, as compressing them costs more time
+// than it saves. Large JSON payloads typically shrink to a fifth or less. A
+// threshold of 0 disables compression, which is the default. It must be
+// called before Run.
+func (d *DevWebServer) SetEventCompression(threshold int) {
+	d.eventCompressionThreshold = threshold
+}
+
+// compressEvent returns the message gzip compressed if it is an event above
+// the compression threshold and the client accepts compressed messages,
+// otherwise message is returned unchanged
+func (d *DevWebServer) compressEvent(client *websocketClient, message interface{}) interface{} {
+	text, ok := message.(string)
+	if !ok || d.eventCompressionThreshold <= 0 || len(text) <= d.eventCompressionThreshold || text[0] != 'n' || !client.acceptsGzip.Load() {
+		return message
+	}
+
+	var compressed bytes.Buffer
+	compressed.WriteString(compressedMagic)
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(text)); err != nil {
+		return message
+	}
+	if err := writer.Close(); err != nil {
+		return message
+	}
+	return compressed.Bytes()
+}
@@ -0,0 +1,111 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+)
+
+func gzipString(t *testing.T, text string) string {
+	t.Helper()
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return compressed.String()
+}
+
+func TestDecompressMessage(t *testing.T) {
+	call := `C{"name":"main.App.Greet","callbackID":"1"}`
+	tests := []struct {
+		name    string
+		enabled bool
+		msg     string
+		want    string
+		wantErr bool
+	}{
+		{"plain", true, call, call, false},
+		{"compressed", true, gzipString(t, call), call, false},
+		{"disabled", false, gzipString(t, call), gzipString(t, call), false},
+		{"truncated", true, gzipMagic + "\x08", "", true},
+		{"bomb", true, gzipString(t, strings.Repeat("C", maxDecompressedMessage+1)), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DevWebServer{inboundDecompression: tt.enabled}
+			got, err := d.decompressMessage(tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decompressMessage() error = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("decompressMessage() returned %d bytes, want %d", len(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestCompressEvent(t *testing.T) {
+	event := `n{"name":"update","data":["` + strings.Repeat("a", 100) + `"]}`
+	tests := []struct {
+		name        string
+		threshold   int
+		acceptsGzip bool
+		message     interface{}
+		compressed  bool
+	}{
+		{"disabled", 0, true, event, false},
+		{"below-threshold", len(event), true, event, false},
+		{"above-threshold", 10, true, event, true},
+		{"gzip-not-accepted", 10, false, event, false},
+		{"not-an-event", 10, true, "c" + event[1:], false},
+		{"binary", 10, true, []byte(event), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DevWebServer{eventCompressionThreshold: tt.threshold}
+			client := &websocketClient{}
+			client.acceptsGzip.Store(tt.acceptsGzip)
+			got := d.compressEvent(client, tt.message)
+			compressed, ok := got.([]byte)
+			if !tt.compressed {
+				if string(messageBytes(got)) != string(messageBytes(tt.message)) {
+					t.Errorf("compressEvent() = '%v', want the message unchanged", got)
+				}
+				return
+			}
+			if !ok || !bytes.HasPrefix(compressed, []byte(compressedMagic)) {
+				t.Fatalf("compressEvent() = '%v', want a compressed event", got)
+			}
+			reader, err := gzip.NewReader(bytes.NewReader(compressed[len(compressedMagic):]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			decompressed, err := io.ReadAll(reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decompressed) != event {
+				t.Errorf("decompressed event = '%s', want '%s'", decompressed, event)
+			}
+		})
+	}
+}
+
+func messageBytes(message interface{}) []byte {
+	switch message := message.(type) {
+	case string:
+		return []byte(message)
+	case []byte:
+		return message
+	}
+	return nil
+}
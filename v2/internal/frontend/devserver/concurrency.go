@@ -0,0 +1,64 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"errors"
+	"strings"
+)
+
+// OverflowPolicy decides what happens to a call of a bound method when the
+// global concurrency limit is reached
+type OverflowPolicy int
+
+const (
+	// OverflowQueue makes the call wait until another call has finished
+	OverflowQueue OverflowPolicy = iota
+	// OverflowReject rejects the call with ErrTooManyCalls
+	OverflowReject
+)
+
+// ErrTooManyCalls is returned to the browser when a call is rejected because
+// the global concurrency limit is reached
+var ErrTooManyCalls = errors.New("too many concurrent calls")
+
+// SetGlobalConcurrency limits the number of bound methods executing at the
+// same time for all browsers together. Calls beyond the limit wait or are
+// rejected, depending on overflow. Calls made by the runtime itself are not
+// limited. A limit of 0, the default, disables it. It must be called before
+// Run.
+func (d *DevWebServer) SetGlobalConcurrency(limit int, overflow OverflowPolicy) {
+	d.callSlots = nil
+	if limit > 0 {
+		d.callSlots = make(chan struct{}, limit)
+	}
+	d.callOverflow = overflow
+}
+
+// acquireCallSlot takes one of the slots for executing bound methods if msg
+// is a call. It returns the function releasing the slot again, which must be
+// called once the call is finished.
+func (d *DevWebServer) acquireCallSlot(msg string) (func(), error) {
+	if d.callSlots == nil {
+		return func() {}, nil
+	}
+	if _, method, ok := d.parseCall(msg); !ok || strings.HasPrefix(method, systemCallPrefix) {
+		return func() {}, nil
+	}
+
+	release := func() { <-d.callSlots }
+	select {
+	case d.callSlots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	if d.callOverflow == OverflowReject {
+		d.logger.Warning("[DevWebServer] Concurrency limit of %d calls reached, rejecting call", cap(d.callSlots))
+		return nil, ErrTooManyCalls
+	}
+	d.logger.Warning("[DevWebServer] Concurrency limit of %d calls reached, queueing call", cap(d.callSlots))
+	d.callSlots <- struct{}{}
+	return release, nil
+}
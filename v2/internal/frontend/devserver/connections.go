@@ -0,0 +1,227 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"time"
+)
+
+// ConnectionEventType is the type of a ConnectionEvent
+type ConnectionEventType string
+
+const (
+	ConnectionOpened ConnectionEventType = "opened"
+	ConnectionClosed ConnectionEventType = "closed"
+	ConnectionError  ConnectionEventType = "error"
+)
+
+// connectionEventsBufferSize is the number of connection events buffered for a slow reader
+const connectionEventsBufferSize = 64
+
+// ConnectionEvent describes a change of a browser's connection to the dev server
+type ConnectionEvent struct {
+	Type       ConnectionEventType
+	ClientID   string
+	RemoteAddr string
+	UserID     string
+	// Err is the error which ended the connection, for ConnectionError events
+	Err error
+	// Left is true for ConnectionClosed events of browsers which announced
+	// they were leaving, e.g. because their tab was closed, rather than
+	// losing the connection
+	Left bool
+}
+
+// Connections returns a channel which receives an event whenever a browser
+// connects, disconnects or its connection fails. The channel is closed when
+// the app shuts down, and is returned closed if it has already shut down.
+// Events are dropped if the channel isn't read from fast enough.
+func (d *DevWebServer) Connections() <-chan ConnectionEvent {
+	d.socketMutex.Lock()
+	defer d.socketMutex.Unlock()
+	if d.connectionEvents == nil {
+		if d.connectionEventsClosed {
+			closed := make(chan ConnectionEvent)
+			close(closed)
+			return closed
+		}
+		d.connectionEvents = make(chan ConnectionEvent, connectionEventsBufferSize)
+	}
+	return d.connectionEvents
+}
+
+func (d *DevWebServer) sendConnectionEvent(eventType ConnectionEventType, client *websocketClient, err error) {
+	d.socketMutex.Lock()
+	defer d.socketMutex.Unlock()
+	if d.connectionEvents == nil {
+		return
+	}
+
+	event := ConnectionEvent{
+		Type:       eventType,
+		ClientID:   client.id,
+		RemoteAddr: client.remoteAddr,
+		UserID:     client.userID,
+		Err:        err,
+		Left:       eventType == ConnectionClosed && client.leaving.Load(),
+	}
+	select {
+	case d.connectionEvents <- event:
+	default:
+		d.logger.Warning("[DevWebServer] Connection events are not read, dropping '%s' event of client %s", eventType, client.id)
+	}
+}
+
+func (d *DevWebServer) closeConnectionEvents() {
+	d.socketMutex.Lock()
+	defer d.socketMutex.Unlock()
+	d.connectionEventsClosed = true
+	if d.connectionEvents != nil {
+		close(d.connectionEvents)
+		// Make sure no more events are sent to the closed channel
+		d.connectionEvents = nil
+	}
+}
+
+// Ready returns true if the browser has connected and reported that its DOM
+// is ready.
+func (d *DevWebServer) Ready(clientID string) bool {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	return ok && client.isReady()
+}
+
+// WaitReady blocks until the browser reported that its DOM is ready. It
+// returns an error if the client disconnects or isn't ready within timeout.
+func (d *DevWebServer) WaitReady(clientID string, timeout time.Duration) error {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return fmt.Errorf("client %s is not connected", clientID)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-client.ready:
+		return nil
+	case <-client.ctx.Done():
+		return fmt.Errorf("client %s disconnected before it was ready", clientID)
+	case <-timer.C:
+		return fmt.Errorf("client %s was not ready within %s", clientID, timeout)
+	}
+}
+
+// ConnectionSnapshot describes a browser connected to the IPC websocket
+type ConnectionSnapshot struct {
+	ClientID    string
+	RemoteAddr  string
+	UserID      string
+	Group       string
+	Ready       bool
+	ConnectedAt time.Time
+	// IdleTime is the time since the last message was received from the browser
+	IdleTime time.Duration
+	// BytesSent is the size of all messages written to the browser
+	BytesSent int64
+}
+
+// ListConnections returns a snapshot of the browsers currently connected to
+// the IPC websocket, ordered by the time they connected.
+func (d *DevWebServer) ListConnections() []ConnectionSnapshot {
+	clients := d.clients()
+	result := make([]ConnectionSnapshot, 0, len(clients))
+	for _, client := range clients {
+		result = append(result, ConnectionSnapshot{
+			ClientID:    client.id,
+			RemoteAddr:  client.remoteAddr,
+			UserID:      client.userID,
+			Group:       client.group,
+			Ready:       client.isReady(),
+			ConnectedAt: client.connectedAt,
+			IdleTime:    client.idleTime(),
+			BytesSent:   client.bytesSent.Load(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ConnectedAt.Before(result[j].ConnectedAt)
+	})
+	return result
+}
+
+// SetConnectionMeta attaches a value to the browser's connection under the
+// given key, e.g. the role of the user from an OnConnect handler. The
+// metadata is discarded when the browser disconnects. It returns an error if
+// the client isn't connected.
+func (d *DevWebServer) SetConnectionMeta(clientID string, key string, value interface{}) error {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return fmt.Errorf("client %s is not connected", clientID)
+	}
+
+	client.metaLock.Lock()
+	defer client.metaLock.Unlock()
+	if client.meta == nil {
+		client.meta = make(map[string]interface{})
+	}
+	client.meta[key] = value
+	return nil
+}
+
+// ConnectionMeta returns the value attached to the browser's connection under
+// the given key. It returns false if the client isn't connected or has no
+// value for the key.
+func (d *DevWebServer) ConnectionMeta(clientID string, key string) (interface{}, bool) {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	client.metaLock.RLock()
+	defer client.metaLock.RUnlock()
+	value, ok := client.meta[key]
+	return value, ok
+}
+
+// OnConnectionClose registers a function which is called when the browser
+// disconnects, e.g. to close a file or subscription opened for it by a bound
+// method. Functions are called in the reverse order they were registered. If
+// the client isn't connected anymore, cleanup is called right away.
+func (d *DevWebServer) OnConnectionClose(clientID string, cleanup func()) {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok || !client.addCleanup(cleanup) {
+		d.runCleanup(clientID, cleanup)
+	}
+}
+
+// runCleanups calls the cleanup functions registered for the client with
+// OnConnectionClose
+func (d *DevWebServer) runCleanups(client *websocketClient) {
+	cleanups := client.takeCleanups()
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		d.runCleanup(client.id, cleanups[i])
+	}
+}
+
+// runCleanup calls a cleanup function, logging a panic instead of letting it
+// skip the remaining cleanups
+func (d *DevWebServer) runCleanup(clientID string, cleanup func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.logger.Error("[DevWebServer] Panic in connection close handler of client %s: %v\n%s", clientID, r, debug.Stack())
+		}
+	}()
+	cleanup()
+}
@@ -0,0 +1,47 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+// ConnectionPolicy decides what happens when a browser connects while another
+// one is already connected
+type ConnectionPolicy int
+
+const (
+	// ConnectionReplaceOld closes the connected browser and accepts the newcomer.
+	// The closed browser is told it was replaced, so it stops reconnecting.
+	ConnectionReplaceOld ConnectionPolicy = iota
+	// ConnectionRejectNew keeps the connected browser and rejects newcomers with RejectConnectionLimit
+	ConnectionRejectNew
+	// ConnectionAllowMultiple accepts any number of browsers
+	ConnectionAllowMultiple
+)
+
+// SetConnectionPolicy sets how a second browser connecting is handled. The
+// default is ConnectionReplaceOld, so there is only ever one frontend and the
+// one opened last is the one in use. Use ConnectionAllowMultiple to connect
+// several browsers at once. It must be called before Run.
+func (d *DevWebServer) SetConnectionPolicy(policy ConnectionPolicy) {
+	d.connectionPolicy = policy
+}
+
+// connectionTaken returns true if the connection policy rejects another
+// browser. The socket mutex must be held.
+func (d *DevWebServer) connectionTaken() bool {
+	return d.connectionPolicy == ConnectionRejectNew && len(d.websocketClients) > 0
+}
+
+// replacedClients returns the clients to close for the given newly connected
+// client. The socket mutex must be held.
+func (d *DevWebServer) replacedClients(client *websocketClient) []*websocketClient {
+	if d.connectionPolicy != ConnectionReplaceOld {
+		return nil
+	}
+	var replaced []*websocketClient
+	for _, other := range d.websocketClients {
+		if other != client {
+			replaced = append(replaced, other)
+		}
+	}
+	return replaced
+}
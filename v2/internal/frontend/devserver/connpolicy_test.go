@@ -0,0 +1,39 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+func TestDefaultConnectionPolicy(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	first := dialTestServer(t, url)
+	waitForClients(t, d, 1)
+	second := dialTestServer(t, url)
+
+	// The browser connected first is told it was replaced and disconnected
+	if err := first.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var message string
+	if err := websocket.Message.Receive(first, &message); err != nil || message != "replaced" {
+		t.Fatalf("first client received '%v' (error '%v'), want 'replaced'", message, err)
+	}
+	if err := websocket.Message.Receive(first, &message); err == nil {
+		t.Errorf("first client received '%v', want it to be disconnected", message)
+	}
+
+	call := `C{"name":"main.App.Greet","callbackID":"1"}`
+	if err := websocket.Message.Send(second, call); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := receiveCallback(t, second); got != "c"+call[1:] {
+		t.Errorf("second client received '%v', want '%v'", got, "c"+call[1:])
+	}
+	waitForClients(t, d, 1)
+}
@@ -0,0 +1,73 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// SetCriticalCSS sets CSS which is inlined in a <style> element in the head
+// of the index.html, so the browser applies it with the first paint instead
+// of after loading the app's stylesheets. It is placed before the
+// stylesheets of the head, so they take precedence over it. It must be
+// called before Run.
+func (d *DevWebServer) SetCriticalCSS(css string) error {
+	if strings.Contains(strings.ToLower(css), "</style") {
+		return errors.New("critical CSS must not contain '</style'")
+	}
+	d.criticalCSS = css
+	return nil
+}
+
+// inlineCriticalCSS adds a <style> element with the CSS to the head of the HTML
+func inlineCriticalCSS(indexHTML []byte, css string) ([]byte, error) {
+	document, err := html.Parse(bytes.NewReader(indexHTML))
+	if err != nil {
+		return nil, err
+	}
+
+	var head *html.Node
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		if node.Type == html.ElementNode && node.DataAtom == atom.Head {
+			head = node
+			return
+		}
+		for child := node.FirstChild; child != nil && head == nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(document)
+	if head == nil {
+		return indexHTML, nil
+	}
+
+	style := &html.Node{
+		Type:     html.ElementNode,
+		Data:     "style",
+		DataAtom: atom.Style,
+	}
+	style.AppendChild(&html.Node{Type: html.TextNode, Data: css})
+
+	// Keep the elements which have to come first, like <meta> and <base>, in front
+	var before *html.Node
+	for child := head.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && (child.DataAtom == atom.Link || child.DataAtom == atom.Style || child.DataAtom == atom.Script) {
+			before = child
+			break
+		}
+	}
+	head.InsertBefore(style, before)
+
+	var buffer bytes.Buffer
+	if err := html.Render(&buffer, document); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
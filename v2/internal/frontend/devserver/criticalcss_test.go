@@ -0,0 +1,69 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"testing"
+)
+
+func TestInlineCriticalCSS(t *testing.T) {
+	const css = "body{margin:0}"
+	tests := []struct {
+		name      string
+		indexHTML string
+		want      string
+	}{
+		{
+			"empty-head",
+			`<html><head></head><body></body></html>`,
+			`<html><head><style>body{margin:0}</style></head><body></body></html>`,
+		},
+		{
+			"after-meta",
+			`<html><head><meta charset="utf-8"/><title>App</title></head><body></body></html>`,
+			`<html><head><meta charset="utf-8"/><title>App</title><style>body{margin:0}</style></head><body></body></html>`,
+		},
+		{
+			"before-stylesheets",
+			`<html><head><meta charset="utf-8"/><link rel="stylesheet" href="/main.css"/><style>p{}</style></head><body></body></html>`,
+			`<html><head><meta charset="utf-8"/><style>body{margin:0}</style><link rel="stylesheet" href="/main.css"/><style>p{}</style></head><body></body></html>`,
+		},
+		{
+			"before-scripts",
+			`<html><head><script src="/main.js"></script></head><body></body></html>`,
+			`<html><head><style>body{margin:0}</style><script src="/main.js"></script></head><body></body></html>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inlineCriticalCSS([]byte(tt.indexHTML), css)
+			if err != nil {
+				t.Fatalf("inlineCriticalCSS() error = '%v'", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("inlineCriticalCSS() = '%s', want '%s'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCriticalCSS(t *testing.T) {
+	tests := []struct {
+		name    string
+		css     string
+		wantErr bool
+	}{
+		{"valid", "body{margin:0}", false},
+		{"closing-tag", "body{}</style><script>", true},
+		{"closing-tag-upper", "body{}</STYLE>", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DevWebServer{}
+			if err := d.SetCriticalCSS(tt.css); (err != nil) != tt.wantErr {
+				t.Errorf("SetCriticalCSS() error = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+		})
+	}
+}
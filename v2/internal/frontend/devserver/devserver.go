@@ -8,13 +8,20 @@ package devserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
-	"strings"
+	"os"
+	"runtime/debug"
+	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
 
@@ -31,6 +38,33 @@ import (
 
 type Screen = frontend.Screen
 
+// defaultHandshakeTimeout is the time a client has to send the request headers
+// of the websocket upgrade before the connection is dropped.
+const defaultHandshakeTimeout = 10 * time.Second
+
+// defaultIdleTimeout is the time an idle keep-alive connection is kept open.
+// ReadTimeout and WriteTimeout are deliberately left unset as they would also
+// apply to the hijacked connections of long-lived websockets.
+const defaultIdleTimeout = 120 * time.Second
+
+// writeTimeout is the time a browser has to accept a message. A browser which
+// stops reading is disconnected after it, so BackpressureBlock can't block the
+// senders forever.
+const writeTimeout = 10 * time.Second
+
+// Routes are the paths the dev server's IPC endpoints are served at
+type Routes struct {
+	// IPC is the path of the IPC websocket and the HTTP IPC endpoint
+	IPC string
+	// Events is the path of the Server-Sent Events endpoint
+	Events string
+}
+
+var defaultRoutes = Routes{
+	IPC:    "/wails/ipc",
+	Events: "/wails/events",
+}
+
 type DevWebServer struct {
 	server           *echo.Echo
 	ctx              context.Context
@@ -39,21 +73,119 @@ type DevWebServer struct {
 	appBindings      *binding.Bindings
 	dispatcher       frontend.Dispatcher
 	socketMutex      sync.Mutex
-	websocketClients map[*websocket.Conn]*sync.Mutex
+	websocketClients map[string]*websocketClient
+	sseClients       map[*sseClient]struct{}
+	lastClientID     uint64
 	menuManager      *menumanager.Manager
 	starttime        string
 
 	// Desktop frontend
 	frontend.Frontend
 
-	devServerAddr string
+	devServerAddr    string
+	routes           Routes
+	handshakeTimeout time.Duration
+	idleTimeout      time.Duration
+
+	connectHandlers []func(id string, ctx context.Context)
+	trafficLog      *trafficLog
+	windowSizeHint  bool
+	unixSocket      string
+
+	authenticator  func(*http.Request) (userID string, ok bool)
+	indexTransform func(html string, req *http.Request) string
+
+	maxEventSize int
+	eventLock    sync.Mutex
+
+	connectionEvents       chan ConnectionEvent
+	connectionEventsClosed bool
+
+	inboundDecompression      bool
+	eventCompressionThreshold int
+	eventsPaused              bool
+	pausedEvents              []outboundEvent
+	// eventsResuming is set while ResumeEvents delivers the buffered events,
+	// newer events are buffered behind them until it is done
+	eventsResuming bool
+
+	pending            pendingSends
+	backpressurePolicy BackpressurePolicy
+
+	unknownMessageHandler func(clientID string, message string)
+
+	notFoundHandler http.Handler
+	spaFallback     bool
+
+	strictVersionCheck bool
+
+	bindingAuthorizer func(clientID string, method string, args []byte) error
+
+	deferredStart bool
+	startLock     sync.Mutex
+	prepared      bool
+	started       bool
+
+	reconnect           bool
+	reconnectMaxBackoff time.Duration
+
+	basePath  string
+	downloads downloads
+
+	tracer Tracer
+
+	recentMessagesSize int
+
+	eventTransformers []func(event *EventNotify) *EventNotify
+
+	callSlots    chan struct{}
+	callOverflow OverflowPolicy
+
+	droppedMessages atomic.Int64
+
+	shutdownLock    sync.Mutex
+	shutdownHooks   []func(ctx context.Context) error
+	shutdownTimeout time.Duration
+
+	connectionGrouper func(req *http.Request, userID string) string
+
+	bigNumberMode bool
+
+	paused atomic.Bool
+
+	connectionPolicy ConnectionPolicy
+
+	metricsInterval  time.Duration
+	messagesSent     atomic.Int64
+	messagesReceived atomic.Int64
+
+	clientConfig     []byte
+	clientConfigLock sync.RWMutex
+
+	requiredBindings []string
+
+	trustedProxies []*net.IPNet
+
+	shutdownCloseCode   int
+	shutdownCloseReason string
+
+	criticalCSS string
+
+	allowedOrigins []string
 }
 
 func (d *DevWebServer) Run(ctx context.Context) error {
 	d.ctx = ctx
 
+	if err := d.checkRequiredBindings(); err != nil {
+		return err
+	}
+
 	d.server.GET("/wails/reload", d.handleReload)
-	d.server.GET("/wails/ipc", d.handleIPCWebSocket)
+	d.server.GET(d.routes.IPC, d.handleIPCWebSocket)
+	d.server.POST(d.routes.IPC, d.handleHTTPIPC)
+	d.server.GET(d.routes.Events, d.handleEvents)
+	d.server.GET(downloadRoute+":token", d.handleDownload)
 
 	assetServerConfig, err := assetserver.BuildAssetServerConfig(d.appoptions)
 	if err != nil {
@@ -97,49 +229,333 @@ func (d *DevWebServer) Run(ctx context.Context) error {
 		log.Fatal(err)
 	}
 
-	assetServer, err := assetserver.NewDevAssetServer(assetHandler, bindingsJSON, ctx.Value("assetdir") != nil, myLogger, runtime.RuntimeAssetsBundle)
+	assetServer, err := assetserver.NewDevAssetServer(assetHandler, bindingsJSON, ctx.Value("assetdir") != nil, myLogger, &runtimeAssets{
+		RuntimeAssets: runtime.RuntimeAssetsBundle,
+		config: &runtimeConfig{
+			IPCPath:             d.ipcPath(),
+			Reconnect:           d.reconnect,
+			ReconnectMaxBackoff: d.reconnectMaxBackoff.Milliseconds(),
+		},
+		clientConfig: d.encodedClientConfig,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if transform := d.buildIndexTransform(); transform != nil {
+		assetServer.UseIndexTransform(transform)
+	}
+
 	d.server.Any("/*", func(c echo.Context) error {
 		if c.IsWebSocket() {
 			wsHandler.ServeHTTP(c.Response(), c.Request())
 		} else {
-			assetServer.ServeHTTP(c.Response(), c.Request())
+			d.serveAsset(assetServer, c.Response(), c.Request())
 		}
 		return nil
 	})
 
-	if devServerAddr := d.devServerAddr; devServerAddr != "" {
+	d.startLock.Lock()
+	d.prepared = true
+	d.startLock.Unlock()
+
+	if d.deferredStart {
+		d.LogDebug("DevServer start deferred until Start is called")
+	} else if err := d.Start(); err != nil {
+		return err
+	}
+
+	// Launch desktop app
+	err = d.Frontend.Run(ctx)
+
+	return err
+}
+
+// SetDeferredStart sets whether Run only sets up the dev server without
+// accepting connections, until Start is called. This allows finishing the
+// configuration of the app, e.g. after a license check, before any browser
+// can connect. It must be called before Run.
+func (d *DevWebServer) SetDeferredStart(deferred bool) {
+	d.deferredStart = deferred
+}
+
+// Start starts serving the dev server set up by Run. It is only needed after
+// SetDeferredStart, as Run starts the dev server by default. Calling it again
+// once the dev server is started has no effect.
+func (d *DevWebServer) Start() error {
+	d.startLock.Lock()
+	defer d.startLock.Unlock()
+	if !d.prepared {
+		return errors.New("the dev server has not been set up by Run yet")
+	}
+	if d.started {
+		return nil
+	}
+
+	devServerAddr := d.devServerAddr
+	if d.unixSocket != "" {
+		listener, err := listenUnix(d.unixSocket)
+		if err != nil {
+			return err
+		}
+		d.server.Listener = listener
+		devServerAddr = "unix:" + d.unixSocket
+	}
+
+	if devServerAddr != "" {
+		d.started = true
+		// Clients that never complete the upgrade request would otherwise hold on to a goroutine forever
+		d.server.Server.ReadHeaderTimeout = d.handshakeTimeout
+		d.server.Server.ConnState = d.logSlowHandshakes(d.server.Server.ConnState)
+
 		// Start server
 		go func(server *echo.Echo, log *logger.Logger) {
-			err := server.Start(devServerAddr)
-			if err != nil {
+			err := server.Start(d.devServerAddr)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
 				log.Error(err.Error())
 			}
 			d.LogDebug("Shutdown completed")
 		}(d.server, d.logger)
 
-		d.LogDebug("Serving DevServer at http://%s", devServerAddr)
+		if d.metricsInterval > 0 {
+			go d.reportMetrics()
+		}
+
+		if d.unixSocket != "" {
+			d.LogDebug("Serving DevServer at %s", devServerAddr)
+		} else {
+			d.LogDebug("Serving DevServer at http://%s", devServerAddr)
+		}
 	}
+	return nil
+}
 
-	// Launch desktop app
-	err = d.Frontend.Run(ctx)
+// SetClientReconnect sets whether the runtime reconnects to the dev server
+// after losing the connection, and the maximum delay between its attempts.
+// The delay starts at 500ms and doubles after every failed attempt. By
+// default, browsers reconnect every 500ms. It must be called before Run.
+func (d *DevWebServer) SetClientReconnect(enabled bool, maxBackoff time.Duration) {
+	d.reconnect = enabled
+	d.reconnectMaxBackoff = maxBackoff
+}
 
-	return err
+// SetHandshakeTimeout sets the time a client has to complete the headers of a
+// request, including the websocket upgrade. Slower clients are disconnected.
+// It must be called before Run and a timeout of 0 disables the limit.
+func (d *DevWebServer) SetHandshakeTimeout(timeout time.Duration) {
+	d.handshakeTimeout = timeout
+}
+
+// SetIdleTimeout sets the time a websocket client may stay silent before it
+// is disconnected with a normal closure. It must be called before Run and a
+// timeout of 0, the default, keeps idle clients connected.
+func (d *DevWebServer) SetIdleTimeout(timeout time.Duration) {
+	d.idleTimeout = timeout
+}
+
+// SetBackpressurePolicy sets what happens when a message is sent to a browser
+// that has more than a few hundred messages queued. The default is
+// BackpressureBlock. It must be called before Run.
+func (d *DevWebServer) SetBackpressurePolicy(policy BackpressurePolicy) {
+	d.backpressurePolicy = policy
+}
+
+// Bindings returns the fully qualified names of all methods bound to the app.
+// The full bindings manifest is already served to every browser as part of
+// the runtime, so this is mostly useful for diagnostics on the Go side.
+func (d *DevWebServer) Bindings() []string {
+	return d.appBindings.DB().MethodNames()
+}
+
+// SendRaw sends the payload to every connected browser without any of the
+// framing used by the Wails runtime. Binary payloads are sent as binary frames
+// and dispatched by the runtime as a "wails:raw" MessageEvent with an
+// ArrayBuffer on window, which the frontend has to handle itself. They must
+// not start with the header of a blob sent by SendBlob. Text frames go through the runtime's
+// regular message handling, so they must not clash with its message types.
+func (d *DevWebServer) SendRaw(payload []byte, binary bool) error {
+	var message interface{} = string(payload)
+	if binary {
+		message = payload
+	}
+
+	var errs []error
+	for _, client := range d.clients() {
+		if err := client.send(message); err != nil {
+			errs = append(errs, fmt.Errorf("client %s: %w", client.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetTrafficLog enables recording of every message sent to and received from
+// the browsers to the file at the given path. Entries are appended as JSON
+// lines and the file is flushed and closed when the app shuts down.
+// It must be called before Run.
+func (d *DevWebServer) SetTrafficLog(path string) error {
+	traffic, err := newTrafficLog(path)
+	if err != nil {
+		return err
+	}
+	if err := d.trafficLog.Close(); err != nil {
+		d.logger.Error(err.Error())
+	}
+	d.trafficLog = traffic
+	return nil
+}
+
+func (d *DevWebServer) RunMainLoop() {
+	d.Frontend.RunMainLoop()
+
+	if err := d.runShutdownHooks(); err != nil {
+		d.logger.Error("Shutdown hooks failed: %s", err.Error())
+	}
+
+	// Closing the server doesn't close hijacked connections like the websockets
+	d.closeClients()
+
+	// Closing the server also removes the unix socket, if one was used
+	if err := d.server.Close(); err != nil {
+		d.logger.Error(err.Error())
+	}
+
+	if err := d.trafficLog.Close(); err != nil {
+		d.logger.Error("Unable to write traffic log: %s", err.Error())
+	}
+
+	d.closeConnectionEvents()
+}
+
+// SetUpgradeAuthenticator sets a function which authenticates every websocket
+// upgrade request, e.g. by validating a session cookie. Requests for which it
+// returns false are rejected with a 401. The returned user ID is associated
+// with the client and can be retrieved with ClientUser.
+func (d *DevWebServer) SetUpgradeAuthenticator(authenticator func(*http.Request) (userID string, ok bool)) {
+	d.authenticator = authenticator
+}
+
+// ClientUser returns the user ID the upgrade authenticator resolved for the
+// given client. It returns false if the client isn't connected.
+func (d *DevWebServer) ClientUser(clientID string) (string, bool) {
+	d.socketMutex.Lock()
+	defer d.socketMutex.Unlock()
+	client, ok := d.websocketClients[clientID]
+	if !ok {
+		return "", false
+	}
+	return client.userID, true
+}
+
+// SetRoutes relocates the IPC endpoints, e.g. to avoid clashes with the
+// routes of a proxy. Empty routes keep their default. The browsers are told
+// about the IPC route through the runtime. It must be called before Run.
+func (d *DevWebServer) SetRoutes(routes Routes) {
+	if routes.IPC != "" {
+		d.routes.IPC = routes.IPC
+	}
+	if routes.Events != "" {
+		d.routes.Events = routes.Events
+	}
+}
+
+// SetIndexTransform sets a function which may modify the index.html served to
+// the browsers on every request, e.g. to insert a CSP nonce or feature flags.
+// It receives the index after the runtime scripts have been added.
+// It must be called before Run.
+func (d *DevWebServer) SetIndexTransform(transform func(html string, req *http.Request) string) {
+	d.indexTransform = transform
+}
+
+// HTTPServer returns the http.Server used by the dev server, so that advanced
+// options like timeouts or MaxHeaderBytes can be configured before Run is
+// called. The handler, address and ErrorLog are set by the dev server and the
+// ReadHeaderTimeout is set with SetHandshakeTimeout.
+func (d *DevWebServer) HTTPServer() *http.Server {
+	return d.server.Server
+}
+
+// SetUnixSocket makes the dev server listen on a unix domain socket at the
+// given path instead of a TCP address. It must be called before Run.
+func (d *DevWebServer) SetUnixSocket(path string) {
+	d.unixSocket = path
+}
+
+// SetWindowSizeHint sets whether browsers are asked to resize themselves to
+// the Width and Height of the app options when they connect. If the browser
+// doesn't allow the window to be resized, the #app element is sized instead.
+func (d *DevWebServer) SetWindowSizeHint(enabled bool) {
+	d.windowSizeHint = enabled
+}
+
+func (d *DevWebServer) sendWindowSizeHint(client *websocketClient) {
+	if d.appoptions.Width <= 0 || d.appoptions.Height <= 0 {
+		return
+	}
+	payload, err := json.Marshal(&frontend.ScreenSize{Width: d.appoptions.Width, Height: d.appoptions.Height})
+	if err != nil {
+		d.logger.Error(err.Error())
+		return
+	}
+	if err := client.sendWait(client.ctx, "s"+string(payload)); err != nil {
+		d.logger.Error(err.Error())
+	}
 }
 
 func (d *DevWebServer) WindowReload() {
-	d.broadcast("reload")
+	d.broadcastPriority("reload")
 	d.Frontend.WindowReload()
 }
 
 func (d *DevWebServer) WindowReloadApp() {
-	d.broadcast("reloadapp")
+	d.broadcastPriority("reloadapp")
 	d.Frontend.WindowReloadApp()
 }
 
+// Navigate instructs every connected browser to navigate to the given URL,
+// replacing the frontend. Unlike BrowserOpenURL, which opens the URL in a new
+// window, the browsers leave the app unless the URL is served by the dev
+// server. Only relative URLs and http(s) URLs are allowed.
+func (d *DevWebServer) Navigate(target string) error {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("invalid navigation URL '%s': %w", target, err)
+	}
+	if parsed.Scheme != "" && parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("invalid navigation URL '%s': scheme '%s' is not allowed", target, parsed.Scheme)
+	}
+	d.LogDebug("Navigating browsers to %s", target)
+	d.broadcast("navigate:" + target)
+	return nil
+}
+
+// ReloadClients instructs every connected browser to reload the frontend.
+// If stagger is non-zero, the reloads are spread evenly over that duration so
+// that the clients don't all request the new assets at the same time, and
+// ReloadClients blocks until the last client has been sent the instruction.
+// It returns the number of clients that were sent the reload instruction.
+func (d *DevWebServer) ReloadClients(stagger time.Duration) int {
+	clients := d.clients()
+
+	var interval time.Duration
+	if stagger > 0 && len(clients) > 1 {
+		interval = stagger / time.Duration(len(clients)-1)
+	}
+
+	for i, client := range clients {
+		if i > 0 && interval > 0 {
+			select {
+			case <-time.After(interval):
+			case <-d.ctx.Done():
+				return i
+			}
+		}
+		d.sendTo(client, "reload")
+	}
+
+	d.LogDebug("Reloading %d client(s)", len(clients))
+	return len(clients)
+}
+
 func (d *DevWebServer) Notify(name string, data ...interface{}) {
 	d.notify(name, data...)
 }
@@ -155,52 +571,229 @@ func (d *DevWebServer) handleReloadApp(c echo.Context) error {
 }
 
 func (d *DevWebServer) handleIPCWebSocket(c echo.Context) error {
+	if code, message := d.checkUpgrade(c); code != "" {
+		return d.reject(c, code, message)
+	}
+
+	var userID string
+	if d.authenticator != nil {
+		var ok bool
+		if userID, ok = d.authenticator(c.Request()); !ok {
+			return d.reject(c, RejectUnauthorized, "authentication failed")
+		}
+	}
+
 	websocket.Handler(func(c *websocket.Conn) {
-		d.LogDebug(fmt.Sprintf("Websocket client %p connected", c))
-		d.socketMutex.Lock()
-		d.websocketClients[c] = &sync.Mutex{}
-		locker := d.websocketClients[c]
+		client := d.addClient(c, userID)
+		if client == nil {
+			return
+		}
+		err := d.serveClient(client)
+		d.removeClient(client, err)
+	}).ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// listenUnix listens on a unix domain socket at path, removing any stale socket
+// left behind by a previous run first.
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("unable to listen on '%s': file exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// addClient registers a newly connected browser. It returns nil if the
+// connection policy doesn't allow another browser.
+func (d *DevWebServer) addClient(c *websocket.Conn, userID string) *websocketClient {
+	d.socketMutex.Lock()
+	// Another browser may have connected since the upgrade was checked
+	if d.connectionTaken() {
 		d.socketMutex.Unlock()
+		d.logger.Warning("[DevWebServer] Closing websocket client %s: another frontend is already connected", d.remoteAddr(c.Request()))
+		c.Close()
+		return nil
+	}
+	d.lastClientID++
+	client := newWebsocketClient(d.ctx, strconv.FormatUint(d.lastClientID, 10), c, d.backpressurePolicy, &d.pending)
+	client.userID = userID
+	client.remoteAddr = d.remoteAddr(c.Request())
+	if d.connectionGrouper != nil {
+		client.group = d.connectionGrouper(c.Request(), userID)
+	}
+	if d.recentMessagesSize > 0 {
+		client.recent = newRecentMessages(d.recentMessagesSize)
+	}
+	client.onDrop = func(message interface{}) {
+		d.messageDropped(client, message)
+	}
+	d.websocketClients[client.id] = client
+	replaced := d.replacedClients(client)
+	connectHandlers := d.connectHandlers
+	d.socketMutex.Unlock()
+	for _, other := range replaced {
+		d.LogDebug("Closing websocket client %s, replaced by client %s", other.id, client.id)
+		// Tell the runtime not to reconnect, it would replace the new client in turn
+		d.trafficLog.record(trafficOutbound, other.id, "replaced")
+		_ = websocket.Message.Send(other.conn, "replaced")
+		other.conn.Close()
+	}
+	go d.writeMessages(client)
+	if userID != "" {
+		d.LogDebug("Websocket client %s connected as user '%s'", client.id, userID)
+	} else {
+		d.LogDebug("Websocket client %s connected", client.id)
+	}
+	d.sendConnectionEvent(ConnectionOpened, client, nil)
+
+	for _, handler := range connectHandlers {
+		go handler(client.id, client.ctx)
+	}
+
+	if d.windowSizeHint {
+		d.sendWindowSizeHint(client)
+	}
+	return client
+}
+
+// removeClient unregisters a browser after its connection has been closed.
+// err is the error which ended the connection, if any.
+func (d *DevWebServer) removeClient(client *websocketClient, err error) {
+	client.conn.Close()
+	d.socketMutex.Lock()
+	delete(d.websocketClients, client.id)
+	d.socketMutex.Unlock()
+	client.cancel()
+	d.runCleanups(client)
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		d.LogDebug("Websocket client %s disconnected: %s", client.id, err.Error())
+		d.sendConnectionEvent(ConnectionError, client, err)
+	} else {
+		d.LogDebug("Websocket client %s disconnected", client.id)
+	}
+	d.sendConnectionEvent(ConnectionClosed, client, nil)
+}
+
+// writeMessages writes the messages queued for a browser until it disconnects.
+// The priority queue is always emptied first.
+func (d *DevWebServer) writeMessages(client *websocketClient) {
+	for {
+		select {
+		case message := <-client.priorityQueue:
+			d.writeMessage(client, message)
+			continue
+		default:
+		}
+
+		select {
+		case message := <-client.priorityQueue:
+			d.writeMessage(client, message)
+		case message := <-client.queue:
+			d.writeMessage(client, message)
+		case <-client.ctx.Done():
+			client.stop()
+			return
+		}
+	}
+}
+
+func (d *DevWebServer) writeMessage(client *websocketClient, message interface{}) {
+	if err := client.bandwidth.wait(client.ctx, messageSize(message)); err != nil {
+		// The client disconnected, stop discards the rest of the queue
+		d.pending.done(err)
+		return
+	}
+	d.trafficLog.record(trafficOutbound, client.id, message)
+	client.recent.record(client.id, message)
+	err := client.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err == nil {
+		err = websocket.Message.Send(client.conn, d.compressEvent(client, message))
+	}
+	if err != nil {
+		d.logger.Error("Unable to write message to client %s: %s", client.id, err.Error())
+		// Ends the read loop, which removes the client
+		client.conn.Close()
+	} else {
+		client.bytesSent.Add(int64(messageSize(message)))
+		d.messagesSent.Add(1)
+	}
+	d.pending.done(err)
+}
+
+// serveClient reads the messages of a browser and recovers from a panic while
+// handling them, so the client is still cleaned up and the server keeps running.
+func (d *DevWebServer) serveClient(client *websocketClient) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.logger.Error("Panic while serving websocket client %s: %v\n%s", client.id, r, debug.Stack())
+			err = fmt.Errorf("panic: %v", r)
+			d.emitIPCError(client, "", err)
+		}
+	}()
+	return d.readMessages(client)
+}
 
-		defer func() {
-			d.socketMutex.Lock()
-			delete(d.websocketClients, c)
-			d.socketMutex.Unlock()
-			d.LogDebug(fmt.Sprintf("Websocket client %p disconnected", c))
-		}()
-
-		var msg string
-		defer c.Close()
-		for {
-			if err := websocket.Message.Receive(c, &msg); err != nil {
-				break
+// readMessages dispatches the messages of a browser until the connection
+// is closed
+func (d *DevWebServer) readMessages(client *websocketClient) error {
+	var received string
+	for {
+		if d.idleTimeout > 0 {
+			if err := client.conn.SetReadDeadline(time.Now().Add(d.idleTimeout)); err != nil {
+				return err
 			}
-			// We do not support drag in browsers
-			if msg == "drag" {
-				continue
+		}
+		if err := websocket.Message.Receive(client.conn, &received); err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				d.logger.Info("Closing websocket client %s: idle for %s", client.id, d.idleTimeout)
+				return nil
 			}
+			return err
+		}
+		client.touch()
+		d.messagesReceived.Add(1)
+		msg, err := d.decompressMessage(received)
+		if err != nil {
+			d.logger.Error("Unable to decompress message from client %s: %s", client.id, err.Error())
+			continue
+		}
+		d.trafficLog.record(trafficInbound, client.id, msg)
 
-			// Notify the other browsers of "EventEmit"
-			if len(msg) > 2 && strings.HasPrefix(string(msg), "EE") {
-				d.notifyExcludingSender([]byte(msg), c)
-			}
+		if err := d.handleMessage(client, msg); err != nil {
+			return err
+		}
+	}
+}
 
-			// Send the message to dispatch to the frontend
-			result, err := d.dispatcher.ProcessMessage(string(msg), d)
-			if err != nil {
-				d.logger.Error(err.Error())
-			}
-			if result != "" {
-				locker.Lock()
-				if err = websocket.Message.Send(c, result); err != nil {
-					locker.Unlock()
-					break
-				}
-				locker.Unlock()
+// logSlowHandshakes returns a ConnState hook which logs connections that have
+// been closed before sending a complete request within the handshake timeout.
+// The hook also calls next, if given.
+func (d *DevWebServer) logSlowHandshakes(next func(net.Conn, http.ConnState)) func(net.Conn, http.ConnState) {
+	var pending sync.Map
+	return func(conn net.Conn, state http.ConnState) {
+		if next != nil {
+			next(conn, state)
+		}
+
+		switch state {
+		case http.StateNew:
+			pending.Store(conn, time.Now())
+		case http.StateActive, http.StateHijacked:
+			pending.Delete(conn)
+		case http.StateClosed:
+			opened, ok := pending.LoadAndDelete(conn)
+			if ok && d.handshakeTimeout > 0 && time.Since(opened.(time.Time)) >= d.handshakeTimeout {
+				d.logger.Warning("[DevWebServer] Dropped client %s: handshake not completed within %s", conn.RemoteAddr(), d.handshakeTimeout)
 			}
 		}
-	}).ServeHTTP(c.Response(), c.Request())
-	return nil
+	}
 }
 
 func (d *DevWebServer) LogDebug(message string, args ...interface{}) {
@@ -212,71 +805,88 @@ type EventNotify struct {
 	Data []interface{} `json:"data"`
 }
 
-func (d *DevWebServer) broadcast(message string) {
+// OnConnect registers a handler which is called in a new goroutine whenever a
+// browser connects. The context passed to the handler is cancelled when that
+// browser disconnects, so any work started for it can be stopped.
+func (d *DevWebServer) OnConnect(handler func(id string, ctx context.Context)) {
 	d.socketMutex.Lock()
 	defer d.socketMutex.Unlock()
-	for client, locker := range d.websocketClients {
-		go func(client *websocket.Conn, locker *sync.Mutex) {
-			if client == nil {
-				d.logger.Error("Lost connection to websocket server")
-				return
-			}
-			locker.Lock()
-			err := websocket.Message.Send(client, message)
-			if err != nil {
-				locker.Unlock()
-				d.logger.Error(err.Error())
-				return
-			}
-			locker.Unlock()
-		}(client, locker)
+	d.connectHandlers = append(d.connectHandlers, handler)
+}
+
+// clients returns a snapshot of the connected clients
+func (d *DevWebServer) clients() []*websocketClient {
+	d.socketMutex.Lock()
+	defer d.socketMutex.Unlock()
+	result := make([]*websocketClient, 0, len(d.websocketClients))
+	for _, client := range d.websocketClients {
+		result = append(result, client)
+	}
+	return result
+}
+
+func (d *DevWebServer) broadcast(message string) {
+	for _, client := range d.clients() {
+		d.sendTo(client, message)
+	}
+}
+
+// broadcastPriority sends a control message to every browser, ahead of the
+// messages already queued for it
+func (d *DevWebServer) broadcastPriority(message string) {
+	for _, client := range d.clients() {
+		if err := client.sendPriority(client.ctx, message); err != nil {
+			d.logger.Error(err.Error())
+			d.pending.fail(err)
+		}
 	}
 }
 
 func (d *DevWebServer) notify(name string, data ...interface{}) {
+	span := d.startEventSpan(name, "")
 	// Notify
-	notification := EventNotify{
-		Name: name,
-		Data: data,
-	}
-	payload, err := json.Marshal(notification)
+	message, err := d.eventMessage(name, data)
 	if err != nil {
 		d.logger.Error(err.Error())
+		span.End(err)
 		return
 	}
-	d.broadcast("n" + string(payload))
+	if message != "" {
+		d.broadcastEvent(message, nil)
+	}
+	span.End(nil)
 }
 
-func (d *DevWebServer) broadcastExcludingSender(message string, sender *websocket.Conn) {
-	d.socketMutex.Lock()
-	defer d.socketMutex.Unlock()
-	for client, locker := range d.websocketClients {
-		go func(client *websocket.Conn, locker *sync.Mutex) {
-			if client == sender {
-				return
-			}
-			locker.Lock()
-			err := websocket.Message.Send(client, message)
-			if err != nil {
-				locker.Unlock()
-				d.logger.Error(err.Error())
-				return
-			}
-			locker.Unlock()
-		}(client, locker)
+// deliverEvent sends the event to all the clients it is for
+func (d *DevWebServer) deliverEvent(event outboundEvent) {
+	if event.target == nil && event.group == "" && !event.websocketOnly {
+		d.socketMutex.Lock()
+		d.notifySSE(event.message)
+		d.socketMutex.Unlock()
+	}
+	for _, client := range d.clients() {
+		if event.isFor(client) {
+			d.sendTo(client, event.message)
+		}
 	}
 }
 
-func (d *DevWebServer) notifyExcludingSender(eventMessage []byte, sender *websocket.Conn) {
-	message := "n" + string(eventMessage[2:])
-	d.broadcastExcludingSender(message, sender)
-
+func (d *DevWebServer) notifyExcludingSender(eventMessage []byte, sender *websocketClient) {
 	var notifyMessage EventNotify
 	err := json.Unmarshal(eventMessage[2:], &notifyMessage)
 	if err != nil {
 		d.logger.Error(err.Error())
 		return
 	}
+
+	// Re-encode the event, so the size limit and big number mode apply to
+	// events from the browsers too
+	message, err := d.eventMessage(notifyMessage.Name, notifyMessage.Data)
+	if err != nil {
+		d.logger.Error(err.Error())
+	} else if message != "" {
+		d.broadcastEvent(message, sender)
+	}
 	d.Frontend.Notify(notifyMessage.Name, notifyMessage.Data...)
 }
 
@@ -290,11 +900,18 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		dispatcher:       dispatcher,
 		server:           echo.New(),
 		menuManager:      menuManager,
-		websocketClients: make(map[*websocket.Conn]*sync.Mutex),
+		websocketClients: make(map[string]*websocketClient),
+		sseClients:       make(map[*sseClient]struct{}),
+		handshakeTimeout: defaultHandshakeTimeout,
+		routes:           defaultRoutes,
+		reconnect:        true,
+		tracer:           noopTracer{},
+		shutdownTimeout:  defaultShutdownTimeout,
 	}
 
 	result.devServerAddr, _ = ctx.Value("devserver").(string)
 	result.server.HideBanner = true
 	result.server.HidePort = true
+	result.server.Server.IdleTimeout = defaultIdleTimeout
 	return result
 }
@@ -0,0 +1,129 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"golang.org/x/net/websocket"
+)
+
+// panickingDispatcher panics on calls of the method "panic" and otherwise
+// returns the message as the call result
+type panickingDispatcher struct{}
+
+func (panickingDispatcher) ProcessMessage(message string, _ frontend.Frontend) (string, error) {
+	if strings.Contains(message, `"panic"`) {
+		panic("dispatcher panicked")
+	}
+	return "c" + message[1:], nil
+}
+
+// testFrontend is the desktop frontend of the test servers, it ignores events
+type testFrontend struct {
+	frontend.Frontend
+}
+
+func (testFrontend) Notify(string, ...interface{}) {}
+
+func newTestServer(t *testing.T, dispatcher frontend.Dispatcher) (*DevWebServer, string) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	d := NewFrontend(ctx, &options.App{}, logger.New(nil), nil, dispatcher, nil, testFrontend{})
+	d.server.GET(d.routes.IPC, d.handleIPCWebSocket)
+	d.server.POST(d.routes.IPC, d.handleHTTPIPC)
+	d.server.GET(d.routes.Events, d.handleEvents)
+	server := httptest.NewServer(d.server)
+	t.Cleanup(server.Close)
+	return d, "ws" + strings.TrimPrefix(server.URL, "http") + d.routes.IPC
+}
+
+func dialTestServer(t *testing.T, url string) *websocket.Conn {
+	t.Helper()
+	// The page is served by the dev server, so it has the server's origin
+	origin := "http://" + strings.SplitN(strings.TrimPrefix(url, "ws://"), "/", 2)[0]
+	conn, err := websocket.Dial(url, "", origin)
+	if err != nil {
+		t.Fatalf("websocket.Dial() error = '%v'", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// waitForClients waits until the number of connected clients is want
+func waitForClients(t *testing.T, d *DevWebServer, want int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(d.clients()) != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d clients connected, want %d", len(d.clients()), want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// receiveCallback returns the first call result received and the messages
+// received before it
+func receiveCallback(t *testing.T, conn *websocket.Conn) (string, []string) {
+	t.Helper()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var before []string
+	for {
+		var message string
+		if err := websocket.Message.Receive(conn, &message); err != nil {
+			t.Fatalf("websocket.Message.Receive() error = '%v'", err)
+		}
+		if strings.HasPrefix(message, "c") {
+			return message, before
+		}
+		before = append(before, message)
+	}
+}
+
+func TestDispatcherPanic(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	d.SetConnectionPolicy(ConnectionAllowMultiple)
+	first := dialTestServer(t, url)
+	waitForClients(t, d, 1)
+	second := dialTestServer(t, url)
+	waitForClients(t, d, 2)
+
+	if err := websocket.Message.Send(first, `C{"name":"panic","callbackID":"1"}`); err != nil {
+		t.Fatal(err)
+	}
+	// The client whose message panicked is disconnected and removed
+	if err := first.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		var message string
+		if err := websocket.Message.Receive(first, &message); err != nil {
+			break
+		}
+	}
+	waitForClients(t, d, 1)
+
+	call := `C{"name":"main.App.Greet","callbackID":"2"}`
+	if err := websocket.Message.Send(second, call); err != nil {
+		t.Fatal(err)
+	}
+	got, before := receiveCallback(t, second)
+	if want := "c" + call[1:]; got != want {
+		t.Errorf("second client received '%v', want '%v'", got, want)
+	}
+	if len(before) != 1 || !strings.Contains(before[0], EventIPCError) {
+		t.Errorf("second client received %q before the result, want the %s event", before, EventIPCError)
+	}
+}
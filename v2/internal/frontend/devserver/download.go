@@ -0,0 +1,118 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// EventDownloadComplete is emitted when a browser has downloaded a file pushed with PushDownload
+const EventDownloadComplete = "wails:download:complete"
+
+const (
+	downloadRoute = "/wails/download/"
+	// downloadTTL is how long a pushed file can be downloaded
+	downloadTTL = time.Minute
+)
+
+// download is a file pushed to the browsers
+type download struct {
+	filename string
+	mimeType string
+	data     []byte
+}
+
+// DownloadComplete is the data of an EventDownloadComplete event
+type DownloadComplete struct {
+	Filename string `json:"filename"`
+	Size     int    `json:"size"`
+}
+
+// downloads holds the files pushed to the browsers by their token
+type downloads struct {
+	lock  sync.Mutex
+	files map[string]*download
+}
+
+// PushDownload makes every connected browser download the data as a file with
+// the given name, without asking the user where to save it first. The file is
+// served over HTTP, so it doesn't block the websocket, and stays available for
+// a minute. EventDownloadComplete is emitted each time it has been downloaded.
+// If mimeType is empty, it's derived from the filename.
+func (d *DevWebServer) PushDownload(filename string, data []byte, mimeType string) error {
+	if filename == "" {
+		return errors.New("download filename must not be empty")
+	}
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(filename))
+	}
+	if mimeType == "" {
+		mimeType = echo.MIMEOctetStream
+	}
+
+	var random [16]byte
+	if _, err := rand.Read(random[:]); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(random[:])
+
+	d.downloads.lock.Lock()
+	if d.downloads.files == nil {
+		d.downloads.files = make(map[string]*download)
+	}
+	d.downloads.files[token] = &download{filename: filename, mimeType: mimeType, data: data}
+	d.downloads.lock.Unlock()
+	time.AfterFunc(downloadTTL, func() {
+		d.downloads.lock.Lock()
+		delete(d.downloads.files, token)
+		d.downloads.lock.Unlock()
+	})
+
+	instruction, err := json.Marshal(map[string]string{
+		"url":      d.basePath + downloadRoute + token,
+		"filename": filename,
+	})
+	if err != nil {
+		return err
+	}
+	d.LogDebug("Pushing download of '%s' (%d bytes) to browsers", filename, len(data))
+	d.broadcast("download:" + string(instruction))
+	return nil
+}
+
+func (d *DevWebServer) handleDownload(c echo.Context) error {
+	if d.authenticator != nil {
+		if _, ok := d.authenticator(c.Request()); !ok {
+			return d.reject(c, RejectUnauthorized, "authentication failed")
+		}
+	}
+
+	d.downloads.lock.Lock()
+	file, ok := d.downloads.files[c.Param("token")]
+	d.downloads.lock.Unlock()
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": file.filename}))
+	header.Set(echo.HeaderContentLength, strconv.Itoa(len(file.data)))
+	if err := c.Blob(http.StatusOK, file.mimeType, file.data); err != nil {
+		return err
+	}
+
+	d.emit(EventDownloadComplete, &DownloadComplete{Filename: file.filename, Size: len(file.data)})
+	return nil
+}
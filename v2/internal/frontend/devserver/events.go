@@ -0,0 +1,291 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// ErrEventTooLarge is returned when an event exceeds the size set with SetMaxEventSize
+var ErrEventTooLarge = errors.New("event too large")
+
+// outboundEvent is an event message on its way to the browsers
+type outboundEvent struct {
+	message string
+	// sender is the client the event originated from, it is never sent back to it
+	sender *websocketClient
+	// target is the only client the event is sent to, if set
+	target *websocketClient
+	// group restricts the event to the clients of a connection group, if set
+	group string
+	// websocketOnly marks messages which aren't events, they are not sent to
+	// the Server-Sent Events clients
+	websocketOnly bool
+}
+
+func (e *outboundEvent) isFor(client *websocketClient) bool {
+	if e.target != nil {
+		return client == e.target
+	}
+	if e.group != "" && client.group != e.group {
+		return false
+	}
+	return client != e.sender
+}
+
+const (
+	// EventIPCError is emitted when a message from a browser could not be dispatched
+	EventIPCError = "wails:ipc:error"
+)
+
+// maxPausedEvents is the number of events buffered while event delivery is paused.
+// When the buffer is full, the oldest events are dropped.
+const maxPausedEvents = 1000
+
+// maxIPCErrorPayload is the number of bytes of an offending message included in an EventIPCError
+const maxIPCErrorPayload = 256
+
+// IPCError is the data of an EventIPCError
+type IPCError struct {
+	Client  string `json:"client"`
+	Error   string `json:"error"`
+	Payload string `json:"payload"`
+}
+
+// emitBackend emits an event to the Go listeners only
+func (d *DevWebServer) emitBackend(name string, data ...interface{}) {
+	if events, ok := d.ctx.Value("events").(frontend.Events); ok {
+		events.Notify(d, name, data...)
+	}
+}
+
+// emit emits an event to the Go listeners and all frontends
+func (d *DevWebServer) emit(name string, data ...interface{}) {
+	if events, ok := d.ctx.Value("events").(frontend.Events); ok {
+		events.Emit(name, data...)
+		return
+	}
+	d.notify(name, data...)
+}
+
+func (d *DevWebServer) emitIPCError(client *websocketClient, message string, err error) {
+	d.emit(EventIPCError, &IPCError{
+		Client:  client.id,
+		Error:   err.Error(),
+		Payload: truncateMessage(message),
+	})
+}
+
+// truncateMessage shortens a message to maxIPCErrorPayload bytes for reporting
+func truncateMessage(message string) string {
+	if len(message) > maxIPCErrorPayload {
+		return message[:maxIPCErrorPayload] + "..."
+	}
+	return message
+}
+
+// PauseEvents stops delivering events to the browsers. Events emitted while
+// paused are buffered and delivered in order by ResumeEvents. Call results
+// are not affected and are still delivered, ahead of the queued events.
+func (d *DevWebServer) PauseEvents() {
+	d.eventLock.Lock()
+	defer d.eventLock.Unlock()
+	d.eventsPaused = true
+}
+
+// ResumeEvents delivers all events buffered since PauseEvents was called and
+// resumes normal event delivery. Events emitted while the buffered events are
+// being delivered are buffered behind them, so they can't overtake them, and
+// emitters don't wait for slow browsers.
+func (d *DevWebServer) ResumeEvents() {
+	d.eventLock.Lock()
+	if !d.eventsPaused || d.eventsResuming {
+		// Either nothing is buffered, or a running ResumeEvents delivers it
+		d.eventsPaused = false
+		d.eventLock.Unlock()
+		return
+	}
+	d.eventsPaused = false
+	d.eventsResuming = true
+
+	for {
+		// Once paused again, the rest is left for the next ResumeEvents
+		if len(d.pausedEvents) == 0 || d.eventsPaused {
+			d.eventsResuming = false
+			d.eventLock.Unlock()
+			return
+		}
+		events := d.pausedEvents
+		d.pausedEvents = nil
+		d.eventLock.Unlock()
+
+		for _, event := range events {
+			d.deliverEvent(event)
+		}
+		d.eventLock.Lock()
+	}
+}
+
+// NotifyTo sends an event to a single browser only. It returns an error if
+// the client isn't connected or the event could not be sent.
+func (d *DevWebServer) NotifyTo(clientID string, name string, data ...interface{}) (err error) {
+	span := d.startEventSpan(name, clientID)
+	defer func() { span.End(err) }()
+
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return fmt.Errorf("client %s is not connected", clientID)
+	}
+
+	message, err := d.eventMessage(name, data)
+	if err != nil || message == "" {
+		return err
+	}
+
+	event := outboundEvent{message: message, target: client}
+	if d.bufferEvent(event) {
+		return nil
+	}
+	return client.send(event.message)
+}
+
+// NotifyWhere sends an event only to the browsers for which predicate returns
+// true. The predicate is called with a copy of the metadata attached to each
+// connection with SetConnectionMeta. It returns the errors of all failed sends.
+func (d *DevWebServer) NotifyWhere(predicate func(meta map[string]interface{}) bool, name string, data ...interface{}) (err error) {
+	span := d.startEventSpan(name, "")
+	defer func() { span.End(err) }()
+
+	message, err := d.eventMessage(name, data)
+	if err != nil || message == "" {
+		return err
+	}
+
+	var errs []error
+	for _, client := range d.clients() {
+		if !predicate(client.metadata()) {
+			continue
+		}
+		event := outboundEvent{message: message, target: client}
+		if d.bufferEvent(event) {
+			continue
+		}
+		if err := client.send(event.message); err != nil {
+			errs = append(errs, fmt.Errorf("client %s: %w", client.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NotifyBlocking sends an event to all browsers and blocks until it has been
+// queued for each of them, waiting for room in their queues regardless of the
+// backpressure policy. A producer calling it in a loop is slowed down to the
+// pace of the slowest browser. runtime.EventsEmit instead returns at once and
+// depending on the policy, drops events for browsers that can't keep up.
+// Unlike runtime.EventsEmit, Go listeners are not notified. It returns
+// ctx.Err() if ctx is done before the event has been queued for all browsers.
+func (d *DevWebServer) NotifyBlocking(ctx context.Context, name string, data ...interface{}) (err error) {
+	span := d.startEventSpan(name, "")
+	defer func() { span.End(err) }()
+
+	message, err := d.eventMessage(name, data)
+	if err != nil || message == "" {
+		return err
+	}
+	if d.bufferEvent(outboundEvent{message: message}) {
+		return nil
+	}
+
+	d.socketMutex.Lock()
+	d.notifySSE(message)
+	d.socketMutex.Unlock()
+
+	var errs []error
+	for _, client := range d.clients() {
+		if err := client.sendWait(ctx, message); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			errs = append(errs, fmt.Errorf("client %s: %w", client.id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetMaxEventSize sets the maximum size in bytes of an encoded event. Larger
+// events are not sent, as they could exceed the limits of the browser or a
+// proxy and break the connection. A size of 0 disables the limit.
+func (d *DevWebServer) SetMaxEventSize(size int) {
+	d.maxEventSize = size
+}
+
+// UseEventTransformer adds a function which is called with every event before
+// it is sent to the browsers, e.g. to redact fields or add a timestamp. It
+// returns the event to send instead, or nil to drop it. Transformers run in
+// the order they were added and don't affect Go listeners. It must be called
+// before Run.
+func (d *DevWebServer) UseEventTransformer(transformer func(event *EventNotify) *EventNotify) {
+	d.eventTransformers = append(d.eventTransformers, transformer)
+}
+
+// eventMessage encodes the event as a notification message. It returns an
+// empty message if a transformer dropped the event.
+func (d *DevWebServer) eventMessage(name string, data []interface{}) (string, error) {
+	event := &EventNotify{Name: name, Data: data}
+	for _, transform := range d.eventTransformers {
+		if event = transform(event); event == nil {
+			return "", nil
+		}
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+	if d.bigNumberMode {
+		if payload, err = quoteBigNumbers(payload); err != nil {
+			return "", err
+		}
+	}
+	if d.maxEventSize > 0 && len(payload) > d.maxEventSize {
+		return "", fmt.Errorf("%w: event '%s' is %d bytes, the maximum is %d bytes", ErrEventTooLarge, event.Name, len(payload), d.maxEventSize)
+	}
+	return "n" + string(payload), nil
+}
+
+// broadcastEvent sends the event message to all clients but the sender,
+// unless event delivery is paused. Events of a sender in a connection group
+// only reach the other clients of that group.
+func (d *DevWebServer) broadcastEvent(message string, sender *websocketClient) {
+	event := outboundEvent{message: message, sender: sender}
+	if sender != nil {
+		event.group = sender.group
+	}
+	if !d.bufferEvent(event) {
+		d.deliverEvent(event)
+	}
+}
+
+// bufferEvent buffers the event if event delivery is paused and reports
+// whether it did so.
+func (d *DevWebServer) bufferEvent(event outboundEvent) bool {
+	d.eventLock.Lock()
+	defer d.eventLock.Unlock()
+	if !d.eventsPaused && !d.eventsResuming {
+		return false
+	}
+	if len(d.pausedEvents) == maxPausedEvents {
+		d.logger.Warning("[DevWebServer] Event buffer full, dropping oldest event")
+		d.pausedEvents = d.pausedEvents[1:]
+	}
+	d.pausedEvents = append(d.pausedEvents, event)
+	return true
+}
@@ -0,0 +1,118 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"golang.org/x/net/websocket"
+)
+
+// stalledClient adds a client whose queue is full and never written
+func stalledClient(t *testing.T, d *DevWebServer) *websocketClient {
+	t.Helper()
+	client := newWebsocketClient(d.ctx, "stalled", nil, BackpressureBlock, &d.pending)
+	t.Cleanup(client.cancel)
+	for i := 0; i < outboundQueueSize; i++ {
+		if err := client.send("filler"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d.socketMutex.Lock()
+	d.websocketClients[client.id] = client
+	d.socketMutex.Unlock()
+	return client
+}
+
+func TestResumeEventsStalledClient(t *testing.T) {
+	d := NewFrontend(context.Background(), &options.App{}, logger.New(nil), nil, nil, nil, nil)
+	client := stalledClient(t, d)
+
+	d.PauseEvents()
+	d.Notify("first")
+	resumed := make(chan struct{})
+	go func() {
+		d.ResumeEvents()
+		close(resumed)
+	}()
+	// Give ResumeEvents the time to block on the stalled client
+	time.Sleep(100 * time.Millisecond)
+
+	// Emitting doesn't wait for the stalled client while the buffered
+	// events are delivered
+	emitted := make(chan struct{})
+	go func() {
+		d.Notify("second")
+		close(emitted)
+	}()
+	select {
+	case <-emitted:
+	case <-time.After(time.Second):
+		t.Fatal("Notify blocked while ResumeEvents was waiting for a stalled client")
+	}
+
+	var events []string
+	for len(events) < 2 {
+		select {
+		case message := <-client.queue:
+			if message != "filler" {
+				events = append(events, message.(string))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %q, want 2 events", events)
+		}
+	}
+	if !strings.Contains(events[0], `"first"`) || !strings.Contains(events[1], `"second"`) {
+		t.Errorf("received %q, want the first event before the second", events)
+	}
+
+	select {
+	case <-resumed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ResumeEvents did not return")
+	}
+	d.eventLock.Lock()
+	defer d.eventLock.Unlock()
+	if d.eventsPaused || d.eventsResuming || len(d.pausedEvents) != 0 {
+		t.Errorf("paused = %v, resuming = %v, %d buffered events, want events resumed", d.eventsPaused, d.eventsResuming, len(d.pausedEvents))
+	}
+}
+
+func TestBrowserEventEncoding(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	d.SetConnectionPolicy(ConnectionAllowMultiple)
+	d.SetMaxEventSize(100)
+	d.SetBigNumberMode(true)
+	sender := dialTestServer(t, url)
+	waitForClients(t, d, 1)
+	receiver := dialTestServer(t, url)
+	waitForClients(t, d, 2)
+
+	events := []string{
+		`EE{"name":"large","data":["` + strings.Repeat("a", 100) + `"]}`,
+		`EE{"name":"id","data":[9007199254740993]}`,
+	}
+	for _, event := range events {
+		if err := websocket.Message.Send(sender, event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := receiver.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var message string
+	if err := websocket.Message.Receive(receiver, &message); err != nil {
+		t.Fatal(err)
+	}
+	// The large event is dropped and the id is sent as a string
+	if want := `n{"data":["9007199254740992"],"name":"id"}`; message != want {
+		t.Errorf("receiver got '%v', want '%v'", message, want)
+	}
+}
@@ -0,0 +1,88 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"sync"
+)
+
+// pendingSends tracks the messages which are queued but not yet written
+type pendingSends struct {
+	lock  sync.Mutex
+	count int
+	// idle is closed once count drops to zero
+	idle chan struct{}
+	// err is the first error since the last flush
+	err error
+}
+
+func (p *pendingSends) add() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.count == 0 {
+		p.idle = make(chan struct{})
+	}
+	p.count++
+}
+
+func (p *pendingSends) done(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	p.count--
+	if p.count == 0 {
+		close(p.idle)
+	}
+}
+
+// fail records an error for a message which could not be queued
+func (p *pendingSends) fail(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *pendingSends) wait(ctx context.Context) error {
+	p.lock.Lock()
+	idle := p.idle
+	pending := p.count > 0
+	p.lock.Unlock()
+
+	if pending {
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	err := p.err
+	p.err = nil
+	return err
+}
+
+// sendTo queues the message for the client from the calling goroutine, so
+// messages keep the order they were emitted in and the client's backpressure
+// policy applies. Errors are logged and returned by the next Flush.
+func (d *DevWebServer) sendTo(client *websocketClient, message interface{}) {
+	if err := client.send(message); err != nil {
+		d.logger.Error(err.Error())
+		d.pending.fail(err)
+	}
+}
+
+// Flush blocks until all messages which are queued, e.g. broadcast events,
+// have been written to the browsers. It returns the
+// first error that occurred while sending since the last Flush, or the error
+// of ctx if it is done before all messages have been written.
+func (d *DevWebServer) Flush(ctx context.Context) error {
+	return d.pending.wait(ctx)
+}
@@ -0,0 +1,79 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPendingSends(t *testing.T) {
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+	tests := []struct {
+		name string
+		// run is called with the pendingSends before wait
+		run  func(p *pendingSends)
+		want error
+	}{
+		{"idle", func(p *pendingSends) {}, nil},
+		{"done", func(p *pendingSends) {
+			p.add()
+			p.add()
+			p.done(nil)
+			p.done(nil)
+		}, nil},
+		{"first-error", func(p *pendingSends) {
+			p.add()
+			p.add()
+			p.done(errFirst)
+			p.done(errSecond)
+		}, errFirst},
+		{"failed", func(p *pendingSends) {
+			p.fail(errFirst)
+			p.add()
+			p.done(errSecond)
+		}, errFirst},
+		{"done-later", func(p *pendingSends) {
+			p.add()
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				p.done(errFirst)
+			}()
+		}, errFirst},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p pendingSends
+			tt.run(&p)
+			if err := p.wait(context.Background()); err != tt.want {
+				t.Errorf("wait() error = '%v', want '%v'", err, tt.want)
+			}
+			// The error is reset by wait
+			if err := p.wait(context.Background()); err != nil {
+				t.Errorf("second wait() error = '%v', want '<nil>'", err)
+			}
+		})
+	}
+}
+
+func TestPendingSendsWaitCancelled(t *testing.T) {
+	var p pendingSends
+	p.add()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := p.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("wait() error = '%v', want '%v'", err, context.DeadlineExceeded)
+	}
+
+	// Messages added after the counter went back to zero are waited for again
+	p.done(nil)
+	p.add()
+	p.done(nil)
+	if err := p.wait(context.Background()); err != nil {
+		t.Errorf("wait() error = '%v', want '<nil>'", err)
+	}
+}
@@ -0,0 +1,44 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"errors"
+	"net/http"
+)
+
+// SetConnectionGrouper sets a function which assigns every browser to a
+// connection group when it connects, e.g. by the tenant of the user resolved
+// by the upgrade authenticator. Events a browser emits only reach the other
+// browsers of its group, and EmitToGroup sends events to a single group.
+// Only these two are scoped: events emitted with runtime.EventsEmit, and the
+// other events of the dev server, still reach the browsers of every group, so
+// data of a single group must only be sent with EmitToGroup. An empty group
+// leaves the browser ungrouped. It must be called before Run.
+func (d *DevWebServer) SetConnectionGrouper(grouper func(req *http.Request, userID string) string) {
+	d.connectionGrouper = grouper
+}
+
+// EmitToGroup sends an event only to the browsers of the connection group,
+// unlike runtime.EventsEmit, which reaches all browsers. Go listeners are not
+// notified.
+func (d *DevWebServer) EmitToGroup(group string, name string, data ...interface{}) (err error) {
+	if group == "" {
+		return errors.New("connection group must not be empty")
+	}
+
+	span := d.startEventSpan(name, "")
+	defer func() { span.End(err) }()
+
+	message, err := d.eventMessage(name, data)
+	if err != nil || message == "" {
+		return err
+	}
+
+	event := outboundEvent{message: message, group: group}
+	if !d.bufferEvent(event) {
+		d.deliverEvent(event)
+	}
+	return nil
+}
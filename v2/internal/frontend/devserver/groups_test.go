@@ -0,0 +1,99 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// receiveEvents returns the names of the events received up to the event "end".
+// Other messages, like the callbacks of the dispatcher, are skipped.
+func receiveEvents(t *testing.T, conn *websocket.Conn) []string {
+	t.Helper()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for {
+		var message string
+		if err := websocket.Message.Receive(conn, &message); err != nil {
+			t.Fatalf("websocket.Message.Receive() error = '%v', received %q", err, names)
+		}
+		if !strings.HasPrefix(message, "n") {
+			continue
+		}
+		name := strings.SplitN(strings.TrimPrefix(message, `n{"name":"`), `"`, 2)[0]
+		if name == "end" {
+			return names
+		}
+		names = append(names, name)
+	}
+}
+
+func TestConnectionGroups(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	d.SetConnectionPolicy(ConnectionAllowMultiple)
+	d.SetConnectionGrouper(func(req *http.Request, _ string) string {
+		return req.URL.Query().Get("group")
+	})
+	a1 := dialTestServer(t, url+"?group=a")
+	waitForClients(t, d, 1)
+	a2 := dialTestServer(t, url+"?group=a")
+	waitForClients(t, d, 2)
+	b1 := dialTestServer(t, url+"?group=b")
+	waitForClients(t, d, 3)
+
+	// Like runtime.EventsEmit, which isn't scoped
+	d.Notify("all")
+	if err := d.EmitToGroup("a", "group"); err != nil {
+		t.Fatal(err)
+	}
+	if err := websocket.Message.Send(a1, `EE{"name":"browser","data":[]}`); err != nil {
+		t.Fatal(err)
+	}
+	// Make sure the event of the browser has been delivered before the end
+	if err := a2.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	var received []string
+	for len(received) < 3 {
+		var message string
+		if err := websocket.Message.Receive(a2, &message); err != nil {
+			t.Fatalf("websocket.Message.Receive() error = '%v'", err)
+		}
+		if strings.HasPrefix(message, "n") {
+			received = append(received, message)
+		}
+	}
+	d.Notify("end")
+
+	tests := []struct {
+		name string
+		conn *websocket.Conn
+		want []string
+	}{
+		{"sender", a1, []string{"all", "group"}},
+		{"same-group", a2, nil},
+		{"other-group", b1, []string{"all"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := receiveEvents(t, tt.conn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("received %q, want %q", got, tt.want)
+			}
+		})
+	}
+	for i, want := range []string{"all", "group", "browser"} {
+		if !strings.Contains(received[i], `"`+want+`"`) {
+			t.Errorf("same group received %q, want the events %q", received, []string{"all", "group", "browser"})
+			break
+		}
+	}
+}
@@ -0,0 +1,53 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// HTMLMode is how UpdateHTML inserts the HTML into the selected elements
+type HTMLMode string
+
+const (
+	// HTMLReplace replaces the content of the elements
+	HTMLReplace HTMLMode = "replace"
+	// HTMLAppend inserts the HTML after the last child of the elements
+	HTMLAppend HTMLMode = "append"
+	// HTMLPrepend inserts the HTML before the first child of the elements
+	HTMLPrepend HTMLMode = "prepend"
+)
+
+// htmlUpdate is the instruction sent to the browsers by UpdateHTML
+type htmlUpdate struct {
+	Selector string   `json:"selector"`
+	HTML     string   `json:"html"`
+	Mode     HTMLMode `json:"mode"`
+}
+
+// UpdateHTML inserts an HTML fragment into every element matching the CSS
+// selector in every connected browser, e.g. to update server rendered parts
+// of the UI. The fragment is parsed by the browser as inert HTML, so scripts
+// in it are not run, but it is not sanitized: it must not contain untrusted
+// markup. Browsers where nothing matches the selector log a warning.
+func (d *DevWebServer) UpdateHTML(selector string, html string, mode HTMLMode) error {
+	if selector == "" {
+		return errors.New("no selector given")
+	}
+	switch mode {
+	case HTMLReplace, HTMLAppend, HTMLPrepend:
+	default:
+		return fmt.Errorf("invalid HTML mode '%s'", mode)
+	}
+
+	instruction, err := json.Marshal(&htmlUpdate{Selector: selector, HTML: html, Mode: mode})
+	if err != nil {
+		return err
+	}
+	d.LogDebug("Updating HTML of '%s' in browsers (%s)", selector, mode)
+	d.broadcast("html:" + string(instruction))
+	return nil
+}
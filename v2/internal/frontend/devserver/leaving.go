@@ -0,0 +1,26 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+// EventClientLeaving is emitted to the Go listeners when a browser announces
+// it is about to disconnect, e.g. because its tab is being closed
+const EventClientLeaving = "wails:client:leaving"
+
+// ClientLeaving is the data of an EventClientLeaving event
+type ClientLeaving struct {
+	Client string `json:"client"`
+	UserID string `json:"userID,omitempty"`
+}
+
+// clientLeaving handles the message a browser sends before its page is unloaded
+func (d *DevWebServer) clientLeaving(client *websocketClient) {
+	if client.leaving.Swap(true) {
+		return
+	}
+	d.LogDebug("Websocket client %s is leaving", client.id)
+	go d.emitBackend(EventClientLeaving, &ClientLeaving{
+		Client: client.id,
+		UserID: client.userID,
+	})
+}
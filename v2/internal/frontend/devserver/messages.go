@@ -0,0 +1,101 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"strings"
+)
+
+// dispatcherMessageTypes are the first characters of the messages handled by
+// frontend.Dispatcher
+const dispatcherMessageTypes = "LECcWBDQSH"
+
+// OnUnknownMessage sets the handler called with the messages of a type
+// neither the dev server nor the dispatcher knows. By default such messages
+// are logged and dropped. It must be called before Run.
+func (d *DevWebServer) OnUnknownMessage(handler func(clientID string, message string)) {
+	d.unknownMessageHandler = handler
+}
+
+// handleMessage routes a message received from a browser by its type. Call
+// results and other replies wait for room in the client's queue whatever the
+// backpressure policy, as the browser would otherwise never hear back.
+func (d *DevWebServer) handleMessage(client *websocketClient, msg string) error {
+	switch {
+	case msg == "drag":
+		// We do not support drag in browsers
+		return nil
+	case msg == compressionGzip:
+		client.acceptsGzip.Store(true)
+		return nil
+	case strings.HasPrefix(msg, "version:"):
+		client.version = strings.TrimPrefix(msg, "version:")
+		return d.checkVersion(client, client.version)
+	case msg == "leaving":
+		d.clientLeaving(client)
+		return nil
+	case msg == "ready":
+		if client.version == "" {
+			if err := d.checkVersion(client, ""); err != nil {
+				return err
+			}
+		}
+		client.setReady()
+		return nil
+	case msg == "" || !strings.ContainsRune(dispatcherMessageTypes, rune(msg[0])):
+		if d.unknownMessageHandler != nil {
+			d.unknownMessageHandler(client.id, msg)
+			return nil
+		}
+		d.logger.Warning("[DevWebServer] Dropping unknown message from client %s: %s", client.id, truncateMessage(msg))
+		return nil
+	}
+
+	if err := validateMessage(msg); err != nil {
+		d.logInvalidMessage(client.id, msg, err)
+		d.emitIPCError(client, strings.ToValidUTF8(msg, "\uFFFD"), err)
+		if callback := d.dispatchErrorCallback(msg, "", err); callback != "" {
+			return client.sendPriority(client.ctx, callback)
+		}
+		return nil
+	}
+
+	// Notify the other browsers of "EventEmit"
+	if len(msg) > 2 && strings.HasPrefix(msg, "EE") {
+		d.notifyExcludingSender([]byte(msg), client)
+	}
+
+	if callback, ok := d.authorizeCall(client.id, client.userID, msg); !ok {
+		if callback == "" {
+			return nil
+		}
+		return client.sendPriority(client.ctx, callback)
+	}
+
+	release, err := d.acquireCallSlot(msg)
+	if err != nil {
+		if callback := d.dispatchErrorCallback(msg, "", err); callback != "" {
+			return client.sendPriority(client.ctx, callback)
+		}
+		return nil
+	}
+	defer release()
+
+	// Send the message to dispatch to the frontend
+	span := d.startMessageSpan(client.id, msg)
+	result, err := d.dispatcher.ProcessMessage(msg, d)
+	span.End(err)
+	if err != nil {
+		d.logger.Error(err.Error())
+		d.emitIPCError(client, msg, err)
+		if callback := d.dispatchErrorCallback(msg, result, err); callback != "" {
+			return client.sendPriority(client.ctx, callback)
+		}
+		return nil
+	}
+	if result != "" {
+		return client.sendPriority(client.ctx, result)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"runtime"
+	"time"
+)
+
+// EventMetrics is emitted periodically with the runtime stats of the app, see SetMetricsInterval
+const EventMetrics = "wails:metrics"
+
+// Metrics is the data of an EventMetrics event
+type Metrics struct {
+	Goroutines int `json:"goroutines"`
+	// HeapAlloc is the number of bytes of allocated heap objects
+	HeapAlloc uint64 `json:"heapAlloc"`
+	// Sys is the number of bytes of memory obtained from the OS
+	Sys   uint64 `json:"sys"`
+	NumGC uint32 `json:"numGC"`
+
+	Connections int `json:"connections"`
+	// MessagesSent and MessagesReceived are the number of websocket messages
+	// per second since the previous event
+	MessagesSent     float64 `json:"messagesSent"`
+	MessagesReceived float64 `json:"messagesReceived"`
+	// Dropped is the number of messages dropped for all clients so far
+	Dropped int64 `json:"dropped"`
+}
+
+// SetMetricsInterval sets the interval at which an EventMetrics event with
+// the memory and goroutine stats of the app and the message rates of the dev
+// server is emitted, e.g. to display them in the frontend. An interval of 0
+// disables it, which is the default. It must be called before Run.
+func (d *DevWebServer) SetMetricsInterval(interval time.Duration) {
+	d.metricsInterval = interval
+}
+
+// reportMetrics emits an EventMetrics event every metricsInterval until the
+// app shuts down
+func (d *DevWebServer) reportMetrics() {
+	ticker := time.NewTicker(d.metricsInterval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	lastSent, lastReceived := d.messagesSent.Load(), d.messagesReceived.Load()
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case now := <-ticker.C:
+			sent, received := d.messagesSent.Load(), d.messagesReceived.Load()
+			elapsed := now.Sub(last).Seconds()
+
+			var mem runtime.MemStats
+			runtime.ReadMemStats(&mem)
+			d.emit(EventMetrics, &Metrics{
+				Goroutines:       runtime.NumGoroutine(),
+				HeapAlloc:        mem.HeapAlloc,
+				Sys:              mem.Sys,
+				NumGC:            mem.NumGC,
+				Connections:      len(d.clients()),
+				MessagesSent:     float64(sent-lastSent) / elapsed,
+				MessagesReceived: float64(received-lastReceived) / elapsed,
+				Dropped:          d.droppedMessages.Load(),
+			})
+
+			last, lastSent, lastReceived = now, sent, received
+		}
+	}
+}
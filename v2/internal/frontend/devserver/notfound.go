@@ -0,0 +1,97 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// SetNotFoundHandler sets the handler which serves requests that match no
+// asset. By default the asset server's empty 404 response is sent.
+// It must be called before Run.
+func (d *DevWebServer) SetNotFoundHandler(handler http.Handler) {
+	d.notFoundHandler = handler
+}
+
+// SetSPAFallback enables serving the index.html for GET requests that match
+// no asset, so frontends with client-side routing can be reloaded on any of
+// their routes. Only requests for paths without a file extension or which
+// accept text/html fall back, so a missing script or image is still a 404.
+// It takes precedence over the handler set by SetNotFoundHandler
+// for GET requests. It must be called before Run.
+func (d *DevWebServer) SetSPAFallback(enabled bool) {
+	d.spaFallback = enabled
+}
+
+// serveAsset serves the request from the asset server and applies the not
+// found handling to requests that match no asset.
+func (d *DevWebServer) serveAsset(assets http.Handler, rw http.ResponseWriter, req *http.Request) {
+	if !d.spaFallback && d.notFoundHandler == nil {
+		assets.ServeHTTP(rw, req)
+		return
+	}
+
+	interceptor := &notFoundInterceptor{ResponseWriter: rw}
+	assets.ServeHTTP(interceptor, req)
+	if !interceptor.notFound {
+		return
+	}
+
+	if d.spaFallback && req.Method == http.MethodGet && req.URL.Path != "/" && isPageRequest(req) {
+		index := req.Clone(req.Context())
+		index.URL.Path = "/"
+		index.URL.RawPath = ""
+		assets.ServeHTTP(rw, index)
+		return
+	}
+
+	if d.notFoundHandler != nil {
+		d.notFoundHandler.ServeHTTP(rw, req)
+		return
+	}
+	rw.WriteHeader(http.StatusNotFound)
+}
+
+// isPageRequest returns true if the request is likely the navigation to a
+// route of the frontend rather than the request of an asset
+func isPageRequest(req *http.Request) bool {
+	return path.Ext(req.URL.Path) == "" || strings.Contains(req.Header.Get("Accept"), "text/html")
+}
+
+// notFoundInterceptor discards a 404 response, so it can be replaced
+type notFoundInterceptor struct {
+	http.ResponseWriter
+	wroteHeader bool
+	notFound    bool
+}
+
+func (i *notFoundInterceptor) WriteHeader(code int) {
+	if i.wroteHeader {
+		return
+	}
+	i.wroteHeader = true
+	if code == http.StatusNotFound {
+		i.notFound = true
+		return
+	}
+	i.ResponseWriter.WriteHeader(code)
+}
+
+func (i *notFoundInterceptor) Write(data []byte) (int, error) {
+	if !i.wroteHeader {
+		i.WriteHeader(http.StatusOK)
+	}
+	if i.notFound {
+		return len(data), nil
+	}
+	return i.ResponseWriter.Write(data)
+}
+
+func (i *notFoundInterceptor) Flush() {
+	if flusher, ok := i.ResponseWriter.(http.Flusher); ok && !i.notFound {
+		flusher.Flush()
+	}
+}
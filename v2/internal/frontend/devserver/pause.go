@@ -0,0 +1,34 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+const (
+	// EventServerPaused is emitted when the dev server stops accepting new connections
+	EventServerPaused = "wails:server:paused"
+	// EventServerResumed is emitted when the dev server accepts new connections again
+	EventServerResumed = "wails:server:resumed"
+)
+
+// Pause stops accepting new websocket connections, e.g. during maintenance or
+// a state migration. Upgrades are rejected with RejectPaused while the
+// connected browsers keep working normally.
+func (d *DevWebServer) Pause() {
+	if d.paused.CompareAndSwap(false, true) {
+		d.logger.Info("[DevWebServer] Paused, not accepting new connections")
+		d.emit(EventServerPaused)
+	}
+}
+
+// Resume accepts new websocket connections again after Pause.
+func (d *DevWebServer) Resume() {
+	if d.paused.CompareAndSwap(true, false) {
+		d.logger.Info("[DevWebServer] Resumed, accepting new connections")
+		d.emit(EventServerResumed)
+	}
+}
+
+// IsPaused returns true if the dev server isn't accepting new connections
+func (d *DevWebServer) IsPaused() bool {
+	return d.paused.Load()
+}
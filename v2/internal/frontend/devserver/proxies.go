@@ -0,0 +1,89 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// SetTrustedProxies sets the addresses of the reverse proxies in front of the
+// dev server, as IPs or CIDR ranges. For requests from a trusted proxy, the
+// address of the browser is taken from the X-Forwarded-For or X-Real-IP
+// header and used in logs and connection info instead of the address of the
+// proxy. The headers of requests from other addresses are ignored, as anyone
+// could set them. It must be called before Run.
+func (d *DevWebServer) SetTrustedProxies(proxies []string) error {
+	trusted := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy '%s'", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy '%s': %w", proxy, err)
+		}
+		trusted = append(trusted, network)
+	}
+	d.trustedProxies = trusted
+	return nil
+}
+
+// isTrustedProxy returns true if the IP is the address of a trusted proxy
+func (d *DevWebServer) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return false
+	}
+	for _, network := range d.trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteAddr returns the address of the browser which sent the request. For
+// requests forwarded by a trusted proxy, it is the IP of the first untrusted
+// hop in X-Forwarded-For, or X-Real-IP if that isn't set.
+func (d *DevWebServer) remoteAddr(req *http.Request) string {
+	if len(d.trustedProxies) == 0 {
+		return req.RemoteAddr
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil || !d.isTrustedProxy(host) {
+		return req.RemoteAddr
+	}
+
+	if forwarded := req.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		// Proxies append the address they received the request from, so the
+		// browser is the last hop which isn't one of the trusted proxies
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			if i == 0 || !d.isTrustedProxy(hop) {
+				return hop
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+	return req.RemoteAddr
+}
@@ -0,0 +1,85 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		trusted []string
+		other   []string
+		wantErr bool
+	}{
+		{"none", nil, nil, []string{"10.0.0.1"}, false},
+		{"ipv4", []string{"10.0.0.1"}, []string{"10.0.0.1"}, []string{"10.0.0.2"}, false},
+		{"ipv6", []string{"::1"}, []string{"::1"}, []string{"::2", "127.0.0.1"}, false},
+		{"cidr", []string{"192.168.0.0/16", "fd00::/8"}, []string{"192.168.1.1", "fd00::1"}, []string{"192.169.0.1", "fe00::1"}, false},
+		{"invalid-ip", []string{"10.0.0"}, nil, nil, true},
+		{"invalid-cidr", []string{"10.0.0.0/33"}, nil, nil, true},
+		{"hostname", []string{"proxy.local"}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &DevWebServer{}
+			err := d.SetTrustedProxies(tt.proxies)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetTrustedProxies() error = '%v', wantErr '%v'", err, tt.wantErr)
+			}
+			for _, ip := range tt.trusted {
+				if !d.isTrustedProxy(ip) {
+					t.Errorf("isTrustedProxy('%v') = 'false', want 'true'", ip)
+				}
+			}
+			for _, ip := range tt.other {
+				if d.isTrustedProxy(ip) {
+					t.Errorf("isTrustedProxy('%v') = 'true', want 'false'", ip)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoteAddr(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{"direct", "203.0.113.7:1234", nil, "", "203.0.113.7:1234"},
+		{"untrusted-proxy", "203.0.113.7:1234", []string{"198.51.100.1"}, "198.51.100.2", "203.0.113.7:1234"},
+		{"forwarded", "10.0.0.1:1234", []string{"198.51.100.1"}, "", "198.51.100.1"},
+		{"forwarded-chain", "10.0.0.1:1234", []string{"198.51.100.9, 198.51.100.1, 10.0.0.2"}, "", "198.51.100.1"},
+		{"forwarded-headers", "10.0.0.1:1234", []string{"198.51.100.9", "198.51.100.1"}, "", "198.51.100.1"},
+		{"forwarded-all-trusted", "10.0.0.1:1234", []string{"10.0.0.3, 10.0.0.2"}, "", "10.0.0.3"},
+		{"forwarded-invalid", "10.0.0.1:1234", []string{"unknown"}, "198.51.100.2", "198.51.100.2"},
+		{"real-ip", "10.0.0.1:1234", nil, " 198.51.100.2 ", "198.51.100.2"},
+		{"real-ip-invalid", "10.0.0.1:1234", nil, "unknown", "10.0.0.1:1234"},
+	}
+	d := &DevWebServer{}
+	if err := d.SetTrustedProxies([]string{"10.0.0.0/8"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, forwarded := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", forwarded)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if got := d.remoteAddr(req); got != tt.want {
+				t.Errorf("remoteAddr() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+	"sync"
+)
+
+// recentMessages keeps the last messages sent to a client in a ring buffer.
+// A nil recentMessages records nothing.
+type recentMessages struct {
+	lock    sync.Mutex
+	entries []TrafficEntry
+	next    int
+	full    bool
+}
+
+func newRecentMessages(size int) *recentMessages {
+	return &recentMessages{entries: make([]TrafficEntry, size)}
+}
+
+func (r *recentMessages) record(clientID string, message interface{}) {
+	if r == nil {
+		return
+	}
+
+	entry := newTrafficEntry(trafficOutbound, clientID, message)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// snapshot returns the recorded messages, oldest first
+func (r *recentMessages) snapshot() []TrafficEntry {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.full {
+		return append([]TrafficEntry(nil), r.entries[:r.next]...)
+	}
+	result := make([]TrafficEntry, 0, len(r.entries))
+	result = append(result, r.entries[r.next:]...)
+	return append(result, r.entries[:r.next]...)
+}
+
+// SetRecentMessages sets the number of messages sent to each browser that are
+// kept in memory for RecentMessages. A size of 0, the default, disables it.
+// It must be called before Run.
+func (d *DevWebServer) SetRecentMessages(size int) {
+	d.recentMessagesSize = size
+}
+
+// RecentMessages returns the last messages sent to the browser, oldest first,
+// to inspect what a misbehaving client has received. It returns an error if
+// the client isn't connected or recording is disabled.
+func (d *DevWebServer) RecentMessages(clientID string) ([]TrafficEntry, error) {
+	d.socketMutex.Lock()
+	client, ok := d.websocketClients[clientID]
+	d.socketMutex.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("client %s is not connected", clientID)
+	}
+	if client.recent == nil {
+		return nil, fmt.Errorf("recording of recent messages is disabled")
+	}
+	return client.recent.snapshot(), nil
+}
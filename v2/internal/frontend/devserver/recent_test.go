@@ -0,0 +1,57 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecentMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		messages []string
+		want     []string
+	}{
+		{"empty", 3, nil, []string{}},
+		{"partial", 3, []string{"a", "b"}, []string{"a", "b"}},
+		{"full", 3, []string{"a", "b", "c"}, []string{"a", "b", "c"}},
+		{"wrapped", 3, []string{"a", "b", "c", "d", "e"}, []string{"c", "d", "e"}},
+		{"wrapped-twice", 2, []string{"a", "b", "c", "d", "e", "f"}, []string{"e", "f"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recent := newRecentMessages(tt.size)
+			for _, message := range tt.messages {
+				recent.record("1", message)
+			}
+			got := []string{}
+			for _, entry := range recent.snapshot() {
+				if entry.Client != "1" || entry.Direction != trafficOutbound {
+					t.Errorf("entry = '%+v', want an outbound message of client 1", entry)
+				}
+				got = append(got, entry.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("snapshot() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecentMessagesNil(t *testing.T) {
+	var recent *recentMessages
+	// A nil recentMessages records nothing
+	recent.record("1", "reload")
+}
+
+func TestRecentMessagesBinary(t *testing.T) {
+	recent := newRecentMessages(1)
+	recent.record("1", []byte{1, 2})
+	got := recent.snapshot()
+	if len(got) != 1 || got[0].Message != "" || !reflect.DeepEqual(got[0].Binary, []byte{1, 2}) {
+		t.Errorf("snapshot() = '%+v', want one binary entry", got)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RejectCode identifies why the dev server refused a websocket connection or
+// an SSE or HTTP IPC request. The code is returned to the client as part of a
+// JSON body together with a matching HTTP status, so clients can react without
+// parsing the message.
+type RejectCode string
+
+const (
+	// RejectNotWebsocket is returned for requests that aren't a websocket upgrade
+	RejectNotWebsocket RejectCode = "not_websocket"
+	// RejectBadOrigin is returned when the Origin header is missing, invalid or not allowed
+	RejectBadOrigin RejectCode = "bad_origin"
+	// RejectUnauthorized is returned when the upgrade authenticator refused the request
+	RejectUnauthorized RejectCode = "unauthorized"
+	// RejectPaused is returned while the dev server is paused
+	RejectPaused RejectCode = "paused"
+	// RejectConnectionLimit is returned when the connection policy doesn't allow another browser
+	RejectConnectionLimit RejectCode = "connection_limit"
+)
+
+var rejectStatus = map[RejectCode]int{
+	RejectNotWebsocket:    http.StatusBadRequest,
+	RejectBadOrigin:       http.StatusForbidden,
+	RejectUnauthorized:    http.StatusUnauthorized,
+	RejectPaused:          http.StatusServiceUnavailable,
+	RejectConnectionLimit: http.StatusConflict,
+}
+
+// Rejection is the body sent to clients whose connection was refused
+type Rejection struct {
+	Code    RejectCode `json:"code"`
+	Message string     `json:"message"`
+}
+
+func (d *DevWebServer) reject(c echo.Context, code RejectCode, message string) error {
+	d.LogDebug("Rejected client %s (%s): %s", d.remoteAddr(c.Request()), code, message)
+	return c.JSON(rejectStatus[code], &Rejection{Code: code, Message: message})
+}
+
+// checkUpgrade validates a websocket upgrade request before it is accepted.
+// An empty code means the request may be upgraded.
+func (d *DevWebServer) checkUpgrade(c echo.Context) (RejectCode, string) {
+	if !c.IsWebSocket() {
+		return RejectNotWebsocket, "expected a websocket upgrade request"
+	}
+
+	if code, message := d.checkOrigin(c.Request()); code != "" {
+		return code, message
+	}
+
+	if d.paused.Load() {
+		return RejectPaused, "the dev server is paused and not accepting new connections"
+	}
+
+	d.socketMutex.Lock()
+	taken := d.connectionTaken()
+	d.socketMutex.Unlock()
+	if taken {
+		return RejectConnectionLimit, "another frontend is already connected"
+	}
+
+	return "", ""
+}
+
+// SetAllowedOrigins sets the origins, e.g. "https://app.example.com", of pages
+// served elsewhere which may connect to the dev server. By default only pages
+// served by the dev server itself, whose origin matches the Host of the
+// request, may connect, so other websites the developer visits can't call
+// the app's methods. It must be called before Run.
+func (d *DevWebServer) SetAllowedOrigins(origins []string) {
+	d.allowedOrigins = make([]string, len(origins))
+	for i, origin := range origins {
+		d.allowedOrigins[i] = strings.TrimSuffix(origin, "/")
+	}
+}
+
+// checkOrigin validates the Origin header of a request from a browser, which
+// has to match the Host of the request or one of the allowed origins. An empty
+// code means the origin is acceptable. Browsers leave out the header for
+// same-origin GET requests, e.g. an EventSource, so it may be missing if the
+// Fetch Metadata marks the request as same-origin.
+func (d *DevWebServer) checkOrigin(r *http.Request) (RejectCode, string) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		if r.Method == http.MethodGet && r.Header.Get("Sec-Fetch-Site") == "same-origin" {
+			return "", ""
+		}
+		return RejectBadOrigin, "missing Origin header"
+	}
+	parsed, err := url.ParseRequestURI(origin)
+	if err != nil {
+		return RejectBadOrigin, "invalid Origin header: " + err.Error()
+	}
+	if strings.EqualFold(parsed.Host, r.Host) {
+		return "", ""
+	}
+	for _, allowed := range d.allowedOrigins {
+		if strings.EqualFold(origin, allowed) {
+			return "", ""
+		}
+	}
+	return RejectBadOrigin, "origin '" + origin + "' is not allowed"
+}
@@ -0,0 +1,72 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	d, url := newTestServer(t, panickingDispatcher{})
+	d.SetAllowedOrigins([]string{"https://app.example.com/"})
+	host := strings.TrimPrefix(strings.TrimSuffix(url, d.routes.IPC), "ws://")
+	call := `C{"name":"main.App.Greet","callbackID":"1"}`
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		upgrade   bool
+		origin    string
+		fetchSite string
+		wantCode  RejectCode
+	}{
+		{"ipc-foreign", http.MethodPost, d.routes.IPC, false, "https://evil.example.com", "", RejectBadOrigin},
+		{"ipc-foreign-port", http.MethodPost, d.routes.IPC, false, "http://127.0.0.1:1", "", RejectBadOrigin},
+		{"ipc-missing", http.MethodPost, d.routes.IPC, false, "", "same-origin", RejectBadOrigin},
+		{"ipc-invalid", http.MethodPost, d.routes.IPC, false, "not a url", "", RejectBadOrigin},
+		{"ipc-same", http.MethodPost, d.routes.IPC, false, "http://" + host, "", ""},
+		{"ipc-allowed", http.MethodPost, d.routes.IPC, false, "https://APP.example.com", "", ""},
+		{"events-foreign", http.MethodGet, d.routes.Events, false, "https://evil.example.com", "", RejectBadOrigin},
+		{"events-missing", http.MethodGet, d.routes.Events, false, "", "cross-site", RejectBadOrigin},
+		{"websocket-foreign", http.MethodGet, d.routes.IPC, true, "https://evil.example.com", "", RejectBadOrigin},
+		{"websocket-missing", http.MethodGet, d.routes.IPC, true, "", "", RejectBadOrigin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(call))
+			req.Host = host
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.fetchSite != "" {
+				req.Header.Set("Sec-Fetch-Site", tt.fetchSite)
+			}
+			if tt.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "websocket")
+			}
+			rw := httptest.NewRecorder()
+			d.server.ServeHTTP(rw, req)
+
+			if tt.wantCode == "" {
+				if rw.Code != http.StatusOK || rw.Body.String() != "c"+call[1:] {
+					t.Errorf("response = %d '%s', want the call result", rw.Code, rw.Body.String())
+				}
+				return
+			}
+			var rejection Rejection
+			if err := json.Unmarshal(rw.Body.Bytes(), &rejection); err != nil {
+				t.Fatalf("response = %d '%s', want a rejection", rw.Code, rw.Body.String())
+			}
+			if rw.Code != http.StatusForbidden || rejection.Code != tt.wantCode {
+				t.Errorf("response = %d '%s', want %d '%s'", rw.Code, rejection.Code, http.StatusForbidden, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/json"
+
+	"github.com/wailsapp/wails/v2/pkg/assetserver"
+)
+
+// runtimeConfig is the configuration of the dev server passed to the
+// websocket IPC runtime as `window.wailsdevconfig`
+type runtimeConfig struct {
+	IPCPath string `json:"ipcPath"`
+	// Reconnect enables reconnecting after the connection has been lost
+	Reconnect bool `json:"reconnect"`
+	// ReconnectMaxBackoff is the maximum delay between connection attempts in milliseconds
+	ReconnectMaxBackoff int64 `json:"reconnectMaxBackoff"`
+}
+
+// runtimeAssets prepends the runtime configuration and the client config to
+// the websocket IPC script
+type runtimeAssets struct {
+	assetserver.RuntimeAssets
+	config *runtimeConfig
+	// clientConfig returns the JSON encoded config set with SetClientConfig
+	clientConfig func() []byte
+}
+
+func (r *runtimeAssets) WebsocketIPC() []byte {
+	config, err := json.Marshal(r.config)
+	if err != nil {
+		return r.RuntimeAssets.WebsocketIPC()
+	}
+
+	clientConfig := r.clientConfig()
+	ipc := r.RuntimeAssets.WebsocketIPC()
+	result := make([]byte, 0, len(config)+len(clientConfig)+len(ipc)+64)
+	result = append(result, "window.wailsdevconfig="...)
+	result = append(result, config...)
+	result = append(result, ";\nwindow.wailsConfig="...)
+	result = append(result, clientConfig...)
+	result = append(result, ";\n"...)
+	return append(result, ipc...)
+}
@@ -0,0 +1,111 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// defaultShutdownTimeout is the time the shutdown hooks have to finish
+const defaultShutdownTimeout = 5 * time.Second
+
+// closeGoingAway is the websocket close code sent to the browsers on shutdown by default
+const closeGoingAway = 1001
+
+// maxCloseReason is the maximum size of the reason of a close frame, whose
+// payload is limited to 125 bytes including the code
+const maxCloseReason = 123
+
+// OnShutdown registers a hook which is called when the app quits, before the
+// dev server stops and disconnects the browsers. Hooks run one after another
+// in the reverse order of their registration and share a context which is
+// cancelled after the shutdown timeout. Returned errors are logged.
+func (d *DevWebServer) OnShutdown(hook func(ctx context.Context) error) {
+	d.shutdownLock.Lock()
+	defer d.shutdownLock.Unlock()
+	d.shutdownHooks = append(d.shutdownHooks, hook)
+}
+
+// SetShutdownTimeout sets the time all shutdown hooks together have to
+// finish. The default is 5 seconds.
+func (d *DevWebServer) SetShutdownTimeout(timeout time.Duration) {
+	d.shutdownLock.Lock()
+	defer d.shutdownLock.Unlock()
+	d.shutdownTimeout = timeout
+}
+
+// runShutdownHooks calls the shutdown hooks in LIFO order and returns all
+// their errors
+func (d *DevWebServer) runShutdownHooks() error {
+	d.shutdownLock.Lock()
+	hooks := d.shutdownHooks
+	timeout := d.shutdownTimeout
+	d.shutdownLock.Unlock()
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetShutdownCloseMessage sets the websocket close code and reason the
+// browsers receive when the app quits, e.g. to tell a restart the runtime
+// should reconnect after from a permanent stop. The default code is 1001
+// (going away) without a reason. The code must be 1000 to 1003, 1007 to 1014
+// or an application code from 3000 to 4999, and the reason at most 123 bytes.
+func (d *DevWebServer) SetShutdownCloseMessage(code int, reason string) error {
+	switch {
+	case code >= 1000 && code <= 1003, code >= 1007 && code <= 1014, code >= 3000 && code <= 4999:
+	default:
+		return fmt.Errorf("invalid close code %d", code)
+	}
+	if len(reason) > maxCloseReason {
+		return fmt.Errorf("close reason is %d bytes, the maximum is %d bytes", len(reason), maxCloseReason)
+	}
+	d.shutdownLock.Lock()
+	defer d.shutdownLock.Unlock()
+	d.shutdownCloseCode = code
+	d.shutdownCloseReason = reason
+	return nil
+}
+
+// closeClients sends the shutdown close frame to all browsers and closes
+// their connections
+func (d *DevWebServer) closeClients() {
+	d.shutdownLock.Lock()
+	code := d.shutdownCloseCode
+	if code == 0 {
+		code = closeGoingAway
+	}
+	payload := make([]byte, 2, 2+len(d.shutdownCloseReason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	payload = append(payload, d.shutdownCloseReason...)
+	d.shutdownLock.Unlock()
+
+	// Conn.Close always sends a normal closure, so the frame is written first
+	closeFrame := websocket.Codec{Marshal: func(interface{}) ([]byte, byte, error) {
+		return payload, websocket.CloseFrame, nil
+	}}
+	for _, client := range d.clients() {
+		if err := closeFrame.Send(client.conn, nil); err != nil {
+			d.LogDebug("Unable to send close frame to client %s: %s", client.id, err.Error())
+		}
+		client.conn.Close()
+	}
+}
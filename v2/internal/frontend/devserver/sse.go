@@ -0,0 +1,147 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// sseBufferSize is the number of events buffered for a slow Server-Sent Events client
+const sseBufferSize = 64
+
+// maxHTTPIPCMessage is the maximum size of a message posted to the HTTP IPC endpoint
+const maxHTTPIPCMessage = 10 << 20
+
+// sseClient is a browser receiving events through Server-Sent Events, for
+// networks where websockets are blocked
+type sseClient struct {
+	messages chan string
+}
+
+// handleEvents streams all events to the client as Server-Sent Events
+func (d *DevWebServer) handleEvents(c echo.Context) error {
+	if code, message := d.checkOrigin(c.Request()); code != "" {
+		return d.reject(c, code, message)
+	}
+	if d.authenticator != nil {
+		if _, ok := d.authenticator(c.Request()); !ok {
+			return d.reject(c, RejectUnauthorized, "authentication failed")
+		}
+	}
+
+	flusher, ok := c.Response().Writer.(http.Flusher)
+	if !ok {
+		return c.String(http.StatusNotImplemented, "streaming is not supported")
+	}
+
+	client := &sseClient{messages: make(chan string, sseBufferSize)}
+	d.socketMutex.Lock()
+	d.sseClients[client] = struct{}{}
+	d.socketMutex.Unlock()
+	d.LogDebug("SSE client %p connected", client)
+
+	defer func() {
+		d.socketMutex.Lock()
+		delete(d.sseClients, client)
+		d.socketMutex.Unlock()
+		d.LogDebug("SSE client %p disconnected", client)
+	}()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	c.Response().WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	done := c.Request().Context().Done()
+	for {
+		select {
+		case <-done:
+			return nil
+		case message := <-client.messages:
+			// Event messages are single line JSON prefixed with the message type
+			if _, err := fmt.Fprintf(c.Response(), "data: %s\n\n", message); err != nil {
+				return nil
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// handleHTTPIPC dispatches a single IPC message posted by a client that can't
+// use the websocket and returns the result in the response body.
+func (d *DevWebServer) handleHTTPIPC(c echo.Context) error {
+	if code, message := d.checkOrigin(c.Request()); code != "" {
+		return d.reject(c, code, message)
+	}
+	var userID string
+	if d.authenticator != nil {
+		var ok bool
+		if userID, ok = d.authenticator(c.Request()); !ok {
+			return d.reject(c, RejectUnauthorized, "authentication failed")
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxHTTPIPCMessage))
+	if err != nil {
+		return err
+	}
+	msg := string(body)
+	if msg != "" {
+		if err := validateMessage(msg); err != nil {
+			d.logInvalidMessage("", msg, err)
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	// Notify the browsers of "EventEmit"
+	if len(msg) > 2 && strings.HasPrefix(msg, "EE") {
+		d.notifyExcludingSender(body, nil)
+	}
+
+	if callback, ok := d.authorizeCall("", userID, msg); !ok {
+		if callback == "" {
+			return c.NoContent(http.StatusNoContent)
+		}
+		return c.String(http.StatusOK, callback)
+	}
+
+	release, err := d.acquireCallSlot(msg)
+	if err != nil {
+		if callback := d.dispatchErrorCallback(msg, "", err); callback != "" {
+			return c.String(http.StatusOK, callback)
+		}
+		return c.String(http.StatusServiceUnavailable, err.Error())
+	}
+	defer release()
+
+	span := d.startMessageSpan("", msg)
+	result, err := d.dispatcher.ProcessMessage(msg, d)
+	span.End(err)
+	if err != nil {
+		d.logger.Error(err.Error())
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	if result == "" {
+		return c.NoContent(http.StatusNoContent)
+	}
+	return c.String(http.StatusOK, result)
+}
+
+// notifySSE sends the event message to all Server-Sent Events clients.
+// Clients that can't keep up miss the event.
+func (d *DevWebServer) notifySSE(message string) {
+	for client := range d.sseClients {
+		select {
+		case client.messages <- message:
+		default:
+			d.logger.Warning("[DevWebServer] SSE client %p is too slow, dropping event", client)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+// Span is a traced operation started by a Tracer
+type Span interface {
+	// SetAttribute attaches a key/value pair to the span
+	SetAttribute(key string, value interface{})
+	// End finishes the span. err is the error the operation failed with, if any.
+	End(err error)
+}
+
+// Tracer creates the spans for the messages handled by the dev server. It can
+// be implemented on top of OpenTelemetry or any other tracing library.
+type Tracer interface {
+	StartSpan(name string) Span
+}
+
+// Span names used by the dev server
+const (
+	SpanIPCCall    = "wails.ipc.call"
+	SpanIPCMessage = "wails.ipc.message"
+	SpanEventSend  = "wails.event.send"
+)
+
+// Span attributes used by the dev server
+const (
+	AttributeClientID    = "wails.client.id"
+	AttributeMethod      = "wails.method"
+	AttributeMessageType = "wails.message.type"
+	AttributeEventName   = "wails.event.name"
+)
+
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(string) Span { return noopSpan{} }
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) End(error)                        {}
+
+// SetTracer sets the tracer which gets a span for every message dispatched
+// for a browser and every event sent to the browsers. Passing nil restores
+// the default tracer, which does nothing. It must be called before Run.
+func (d *DevWebServer) SetTracer(tracer Tracer) {
+	if tracer == nil {
+		tracer = noopTracer{}
+	}
+	d.tracer = tracer
+}
+
+// startMessageSpan starts the span for dispatching a message of a browser
+func (d *DevWebServer) startMessageSpan(clientID string, msg string) Span {
+	if _, ok := d.tracer.(noopTracer); ok {
+		return noopSpan{}
+	}
+
+	var span Span
+	if _, method, ok := d.parseCall(msg); ok {
+		span = d.tracer.StartSpan(SpanIPCCall)
+		span.SetAttribute(AttributeMethod, method)
+	} else {
+		span = d.tracer.StartSpan(SpanIPCMessage)
+		if msg != "" {
+			span.SetAttribute(AttributeMessageType, msg[:1])
+		}
+	}
+	if clientID != "" {
+		span.SetAttribute(AttributeClientID, clientID)
+	}
+	return span
+}
+
+// startEventSpan starts the span for sending an event to the browsers.
+// clientID is empty if the event is sent to all of them.
+func (d *DevWebServer) startEventSpan(name string, clientID string) Span {
+	span := d.tracer.StartSpan(SpanEventSend)
+	span.SetAttribute(AttributeEventName, name)
+	if clientID != "" {
+		span.SetAttribute(AttributeClientID, clientID)
+	}
+	return span
+}
@@ -0,0 +1,128 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	trafficInbound  = "in"
+	trafficOutbound = "out"
+)
+
+// TrafficEntry is a single message recorded in a traffic log. The log is
+// written as one JSON encoded entry per line.
+type TrafficEntry struct {
+	Time      time.Time `json:"time"`
+	Direction string    `json:"direction"`
+	Client    string    `json:"client"`
+	Message   string    `json:"message,omitempty"`
+	Binary    []byte    `json:"binary,omitempty"`
+}
+
+// trafficLog records all websocket messages to a file. A nil trafficLog
+// records nothing.
+type trafficLog struct {
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+	lock    sync.Mutex
+}
+
+func newTrafficLog(path string) (*trafficLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	result := &trafficLog{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}
+	result.encoder = json.NewEncoder(result.writer)
+	return result, nil
+}
+
+func newTrafficEntry(direction string, clientID string, message interface{}) TrafficEntry {
+	entry := TrafficEntry{
+		Time:      time.Now(),
+		Direction: direction,
+		Client:    clientID,
+	}
+	switch message := message.(type) {
+	case string:
+		entry.Message = message
+	case []byte:
+		entry.Binary = message
+	}
+	return entry
+}
+
+func (t *trafficLog) record(direction string, clientID string, message interface{}) {
+	if t == nil {
+		return
+	}
+
+	entry := newTrafficEntry(direction, clientID, message)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	_ = t.encoder.Encode(&entry)
+}
+
+func (t *trafficLog) Close() error {
+	if t == nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if err := t.writer.Flush(); err != nil {
+		t.file.Close()
+		return err
+	}
+	return t.file.Close()
+}
+
+// ReplayTrafficLog reads a traffic log written by SetTrafficLog and dispatches
+// all the recorded inbound messages in order, as if the browsers had sent
+// them again. If realtime is true, the original delays between messages are
+// kept, otherwise the messages are dispatched as fast as possible.
+func (d *DevWebServer) ReplayTrafficLog(path string, realtime bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var previous time.Time
+	decoder := json.NewDecoder(file)
+	for line := 1; ; line++ {
+		var entry TrafficEntry
+		if err := decoder.Decode(&entry); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("invalid traffic log entry %d: %w", line, err)
+		}
+		// Drag and ready messages are handled by the dev server itself
+		if entry.Direction != trafficInbound || entry.Message == "" || entry.Message == "drag" || entry.Message == "ready" {
+			continue
+		}
+
+		if realtime && !previous.IsZero() {
+			time.Sleep(entry.Time.Sub(previous))
+		}
+		previous = entry.Time
+
+		d.LogDebug("Replaying message from client %s: %s", entry.Client, entry.Message)
+		if _, err := d.dispatcher.ProcessMessage(entry.Message, d); err != nil {
+			d.logger.Error(err.Error())
+		}
+	}
+}
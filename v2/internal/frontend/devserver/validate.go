@@ -0,0 +1,54 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLoggedInvalidBytes is the number of bytes of an invalid message that are logged
+const maxLoggedInvalidBytes = 64
+
+var (
+	errInvalidUTF8 = errors.New("message is not valid UTF-8")
+	errInvalidJSON = errors.New("message payload is not valid JSON")
+)
+
+// validateMessage checks a message for the dispatcher before it is processed,
+// so malformed messages are rejected with a clear error instead of failing
+// deep inside the dispatcher.
+func validateMessage(msg string) error {
+	if !utf8.ValidString(msg) {
+		return errInvalidUTF8
+	}
+
+	var payload string
+	switch {
+	case msg[0] == 'C' || msg[0] == 'c':
+		payload = msg[1:]
+	case strings.HasPrefix(msg, "EE"):
+		payload = msg[2:]
+	default:
+		return nil
+	}
+	if !json.Valid([]byte(payload)) {
+		return errInvalidJSON
+	}
+	return nil
+}
+
+// logInvalidMessage logs the start of an invalid message as hex
+func (d *DevWebServer) logInvalidMessage(clientID string, msg string, err error) {
+	raw := []byte(msg)
+	suffix := ""
+	if len(raw) > maxLoggedInvalidBytes {
+		raw = raw[:maxLoggedInvalidBytes]
+		suffix = "..."
+	}
+	d.logger.Error("[DevWebServer] Rejected message from client '%s': %s: %s%s", clientID, err.Error(), hex.EncodeToString(raw), suffix)
+}
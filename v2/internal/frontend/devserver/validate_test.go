@@ -0,0 +1,33 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"testing"
+)
+
+func TestValidateMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want error
+	}{
+		{"call", `C{"name":"main.App.Greet","args":["World"],"callbackID":"1"}`, nil},
+		{"callback", `c{"id":"1","result":"Hello"}`, nil},
+		{"event-emit", `EE{"name":"update","data":[1]}`, nil},
+		{"other-type", `Q`, nil},
+		{"unchecked-payload", `Lnot json`, nil},
+		{"invalid-utf8", "C{\"name\":\"\xff\"}", errInvalidUTF8},
+		{"invalid-call", `C{"name":`, errInvalidJSON},
+		{"invalid-callback", `cnot json`, errInvalidJSON},
+		{"invalid-event-emit", `EE`, errInvalidJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateMessage(tt.msg); got != tt.want {
+				t.Errorf("validateMessage() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
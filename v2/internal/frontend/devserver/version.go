@@ -0,0 +1,50 @@
+//go:build dev
+// +build dev
+
+package devserver
+
+import (
+	"fmt"
+
+	"golang.org/x/net/websocket"
+)
+
+// runtimeProtocolVersion is the version of the protocol between the dev
+// server and the websocket IPC runtime. It has to match `protocolVersion` in
+// runtime/dev/main.js and must be bumped whenever either side changes in an
+// incompatible way.
+const runtimeProtocolVersion = "1"
+
+// SetStrictVersionCheck sets whether browsers running a runtime with a
+// different protocol version are disconnected. Otherwise the mismatch is
+// only logged. It must be called before Run.
+func (d *DevWebServer) SetStrictVersionCheck(strict bool) {
+	d.strictVersionCheck = strict
+}
+
+// checkVersion compares the protocol version reported by the runtime of a
+// browser with the one of the dev server. An empty version means the runtime
+// predates the version check. In strict mode, an error is returned on a
+// mismatch and the browser is told to stop reconnecting.
+func (d *DevWebServer) checkVersion(client *websocketClient, version string) error {
+	if version == runtimeProtocolVersion {
+		return nil
+	}
+
+	if version == "" {
+		version = "unknown"
+	}
+	message := fmt.Sprintf("runtime protocol version %s of client %s does not match the dev server's version %s, the runtime needs to be rebuilt", version, client.id, runtimeProtocolVersion)
+	if !d.strictVersionCheck {
+		d.logger.Warning("[DevWebServer] %s", message)
+		return nil
+	}
+	d.logger.Error("[DevWebServer] %s", message)
+	// The connection is closed right after, so the message can't wait for the queue
+	notice := "incompatible:" + message
+	d.trafficLog.record(trafficOutbound, client.id, notice)
+	if err := websocket.Message.Send(client.conn, notice); err != nil {
+		return err
+	}
+	return fmt.Errorf("incompatible runtime: %s", message)
+}
@@ -140,6 +140,18 @@ export function EventsNotify(notifyMessage) {
     notifyListeners(message);
 }
 
+/**
+ * NotifyData informs frontend listeners of an event with data that can't be encoded as JSON,
+ * such as the ArrayBuffer of a blob sent by the backend
+ *
+ * @export
+ * @param {string} eventName
+ * @param {...any} data
+ */
+export function EventsNotifyData(eventName, ...data) {
+    notifyListeners({name: eventName, data});
+}
+
 /**
  * Emit an event with the given name and data
  *
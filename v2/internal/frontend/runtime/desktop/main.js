@@ -9,7 +9,7 @@ The electron alternative for Go
 */
 /* jshint esversion: 9 */
 import * as Log from './log';
-import {eventListeners, EventsEmit, EventsNotify, EventsOff, EventsOn, EventsOnce, EventsOnMultiple} from './events';
+import {eventListeners, EventsEmit, EventsNotify, EventsNotifyData, EventsOff, EventsOn, EventsOnce, EventsOnMultiple} from './events';
 import {Call, Callback, callbacks} from './calls';
 import {SetBindings} from "./bindings";
 import * as Window from "./window";
@@ -58,6 +58,7 @@ window.runtime = {
 window.wails = {
     Callback,
     EventsNotify,
+    EventsNotifyData,
     SetBindings,
     eventListeners,
     callbacks,
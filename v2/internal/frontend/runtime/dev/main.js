@@ -34,17 +34,47 @@ window.addEventListener('DOMContentLoaded', () => {
     });
 });
 
+// Version of the protocol between this runtime and the dev server.
+// It has to match `runtimeProtocolVersion` in devserver/version.go.
+const protocolVersion = "1";
+
+// The configuration of the dev server, see devserver/runtimeconfig.go
+const devConfig = window.wailsdevconfig || {};
+const reconnect = devConfig.reconnect !== false;
+const minReconnectDelay = 500;
+const maxReconnectDelay = Math.max(devConfig.reconnectMaxBackoff || minReconnectDelay, minReconnectDelay);
+
 let websocket = null;
 let connectTimer;
+let connectDelay = minReconnectDelay;
+// Set once the dev server rejected this runtime, so we stop reconnecting
+let incompatible = false;
+// Set once another frontend replaced this one, so we stop reconnecting
+let replaced = false;
 
-window.onbeforeunload = function () {
+// Tells the backend this client is leaving on purpose before closing the connection
+function leave() {
+    clearTimeout(connectTimer);
     if (websocket) {
         websocket.onclose = function () {
         };
+        if (websocket.readyState === WebSocket.OPEN) {
+            websocket.send('leaving');
+        }
         websocket.close();
         websocket = null;
     }
-};
+}
+
+// pagehide also fires when the page is put into the back/forward cache, so the
+// connection is opened again if the page is restored from it
+window.addEventListener('pagehide', leave);
+window.addEventListener('pageshow', (event) => {
+    if (event.persisted && websocket == null) {
+        connectDelay = minReconnectDelay;
+        connect();
+    }
+});
 
 // ...and attempt to connect
 connect();
@@ -65,22 +95,44 @@ function handleConnect() {
     log('Connected to backend');
     hideOverlay();
     setupIPCBridge();
-    clearInterval(connectTimer);
+    clearTimeout(connectTimer);
+    connectDelay = minReconnectDelay;
     websocket.onclose = handleDisconnect;
-    websocket.onmessage = handleMessage;
+    websocket.onmessage = receiveMessage;
+    websocket.send('version:' + protocolVersion);
+    if (typeof DecompressionStream !== 'undefined') {
+        websocket.send('compression:gzip');
+    }
+    notifyReady();
 }
 
-// Handles websocket disconnects
-function handleDisconnect() {
-    log('Disconnected from backend');
+// Tells the backend once the DOM of this client is ready
+function notifyReady() {
+    if (document.readyState === 'loading') {
+        window.addEventListener('DOMContentLoaded', notifyReady, {once: true});
+        return;
+    }
+    if (websocket) {
+        websocket.send('ready');
+    }
+}
+
+// Handles websocket disconnects. The close reason is set by the dev server on shutdown.
+function handleDisconnect(event) {
+    log('Disconnected from backend (' + event.code + (event.reason ? ': ' + event.reason : '') + ')');
     websocket = null;
     showOverlay();
+    if (incompatible || replaced || !reconnect) {
+        return;
+    }
     connect();
 }
 
 function _connect() {
     if (websocket == null) {
-        websocket = new WebSocket((window.location.protocol.startsWith("https") ? "wss://" : "ws://") + window.location.host + "/wails/ipc");
+        const ipcPath = devConfig.ipcPath || "/wails/ipc";
+        websocket = new WebSocket((window.location.protocol.startsWith("https") ? "wss://" : "ws://") + window.location.host + ipcPath);
+        websocket.binaryType = "arraybuffer";
         websocket.onopen = handleConnect;
         websocket.onerror = function (e) {
             e.stopImmediatePropagation();
@@ -92,14 +144,57 @@ function _connect() {
     }
 }
 
-// Try to connect to the backend every .5s
+// Try to connect to the backend, backing off exponentially from .5s up to the configured maximum
 function connect() {
     _connect();
-    connectTimer = setInterval(_connect, 500);
+    connectTimer = setTimeout(() => {
+        connectDelay = Math.min(connectDelay * 2, maxReconnectDelay);
+        connect();
+    }, connectDelay);
+}
+
+// Messages are handled one after another, in the order they were received, as
+// decompressing a message is asynchronous
+let received = Promise.resolve();
+
+function receiveMessage(message) {
+    received = received
+        .then(() => decompressMessage(message.data))
+        .then((data) => handleMessage({data: data}))
+        .catch((e) => log('Unable to handle message: ' + e));
+}
+
+// Magic bytes in front of the gzip stream of a compressed event, see devserver/compression.go
+const compressedMagic = "WLGZ";
+
+// Decompresses events the dev server sent gzip compressed, see SetEventCompression
+async function decompressMessage(data) {
+    if (typeof data === "string") {
+        return data;
+    }
+    const blob = data instanceof Blob ? data : new Blob([data]);
+    const magic = await blob.slice(0, compressedMagic.length).text();
+    if (magic !== compressedMagic) {
+        return data;
+    }
+    const stream = blob.slice(compressedMagic.length).stream();
+    return new Response(stream.pipeThrough(new DecompressionStream('gzip'))).text();
 }
 
 function handleMessage(message) {
 
+    if (typeof message.data !== "string") {
+        // Blobs sent from Go with SendBlob are delivered to the listeners of their channel
+        const blob = parseBlob(message.data);
+        if (blob) {
+            window.wails.EventsNotifyData(blob.channel, blob.data);
+            return;
+        }
+        // Raw frames sent from Go with SendRaw are handed to the application untouched
+        window.dispatchEvent(new MessageEvent("wails:raw", {data: message.data}));
+        return;
+    }
+
     if (message.data === "reload") {
         window.runtime.WindowReload();
         return;
@@ -108,6 +203,33 @@ function handleMessage(message) {
         window.runtime.WindowReloadApp()
         return;
     }
+    if (message.data.startsWith("navigate:")) {
+        window.location.href = message.data.slice("navigate:".length);
+        return;
+    }
+    if (message.data.startsWith("download:")) {
+        downloadFile(JSON.parse(message.data.slice("download:".length)));
+        return;
+    }
+    if (message.data.startsWith("config:")) {
+        // Updated with SetClientConfig, the wails:config event follows
+        window.wailsConfig = JSON.parse(message.data.slice("config:".length));
+        return;
+    }
+    if (message.data.startsWith("html:")) {
+        updateHTML(JSON.parse(message.data.slice("html:".length)));
+        return;
+    }
+    if (message.data === "replaced") {
+        replaced = true;
+        console.warn("Wails: Disconnected, another frontend connected to the dev server");
+        return;
+    }
+    if (message.data.startsWith("incompatible:")) {
+        incompatible = true;
+        console.error("Wails: " + message.data.slice("incompatible:".length));
+        return;
+    }
 
     // As a bridge we ignore js and css injections
     switch (message.data[0]) {
@@ -119,7 +241,94 @@ function handleMessage(message) {
             const callbackData = message.data.slice(1);
             window.wails.Callback(callbackData);
             break;
+        // Window size hint
+        case 's':
+            resizeWindow(JSON.parse(message.data.slice(1)));
+            break;
         default:
             log('Unknown message: ' + message.data);
     }
 }
+
+// Downloads a file pushed from Go with PushDownload
+function downloadFile(file) {
+    const link = document.createElement('a');
+    link.href = file.url;
+    link.download = file.filename;
+    link.style.display = 'none';
+    document.body.appendChild(link);
+    link.click();
+    link.remove();
+}
+
+// Inserts an HTML fragment sent from Go with UpdateHTML into the elements matching its selector.
+// The fragment is parsed by a template element, so its scripts are not run.
+function updateHTML(update) {
+    let elements;
+    try {
+        elements = document.querySelectorAll(update.selector);
+    } catch (e) {
+        console.warn("Wails: Invalid selector '" + update.selector + "': " + e.message);
+        return;
+    }
+    if (elements.length === 0) {
+        console.warn("Wails: No elements match '" + update.selector + "'");
+        return;
+    }
+    const template = document.createElement('template');
+    template.innerHTML = update.html;
+    elements.forEach((element) => {
+        const fragment = template.content.cloneNode(true);
+        switch (update.mode) {
+            case 'append':
+                element.append(fragment);
+                break;
+            case 'prepend':
+                element.prepend(fragment);
+                break;
+            default:
+                element.replaceChildren(fragment);
+        }
+    });
+}
+
+// Magic bytes of the header of a blob frame, see devserver/blob.go
+const blobMagic = [0x57, 0x4c, 0x42, 0x42]; // "WLBB"
+
+// Parses a blob frame: the magic bytes, the length of the channel name as a big endian
+// uint16, the channel name and the data. Returns null if the frame isn't a blob.
+function parseBlob(buffer) {
+    const header = blobMagic.length + 2;
+    if (buffer.byteLength < header) {
+        return null;
+    }
+    const view = new DataView(buffer);
+    for (let i = 0; i < blobMagic.length; i++) {
+        if (view.getUint8(i) !== blobMagic[i]) {
+            return null;
+        }
+    }
+    const channelLength = view.getUint16(blobMagic.length);
+    if (buffer.byteLength < header + channelLength) {
+        return null;
+    }
+    return {
+        channel: new TextDecoder().decode(new Uint8Array(buffer, header, channelLength)),
+        data: buffer.slice(header + channelLength),
+    };
+}
+
+// Browsers usually only allow scripts to resize windows they opened themselves,
+// so fall back to sizing the app element if resizing the window was blocked.
+function resizeWindow(size) {
+    window.resizeTo(size.width, size.height);
+    if (window.outerWidth === size.width && window.outerHeight === size.height) {
+        return;
+    }
+    log('Browser blocked resizing the window to ' + size.width + 'x' + size.height);
+    const app = document.getElementById('app');
+    if (app) {
+        app.style.width = size.width + 'px';
+        app.style.height = size.height + 'px';
+    }
+}
@@ -2,6 +2,7 @@ package assetserver
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"embed"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 )
@@ -35,6 +37,10 @@ type assetHandler struct {
 	logger Logger
 
 	retryMissingFiles bool
+
+	// etags caches the content based ETags of the files without a modification time
+	etags     map[string]string
+	etagMutex sync.Mutex
 }
 
 func NewAssetHandler(options assetserver.Options, log Logger) (http.Handler, error) {
@@ -170,6 +176,16 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 	}
 
 	if fileSeeker, _ := file.(io.ReadSeeker); fileSeeker != nil {
+		// Files without a modification time, e.g. from an embed.FS, can't be validated
+		// with If-Modified-Since, so use a content based ETag for those instead.
+		if etagsEnabled && statInfo.ModTime().IsZero() {
+			tag, err := d.fileETag(filename, fileSeeker)
+			if err != nil {
+				return err
+			}
+			rw.Header().Set(HeaderETag, tag)
+		}
+
 		if _, err := fileSeeker.Seek(0, io.SeekStart); err != nil {
 			return fmt.Errorf("seeker can't seek")
 		}
@@ -192,6 +208,35 @@ func (d *assetHandler) serveFSFile(rw http.ResponseWriter, req *http.Request, fi
 	return err
 }
 
+// fileETag returns the content based ETag of a file. Files without a
+// modification time, e.g. from an embed.FS, don't change while the app is
+// running, so the ETag is only computed once per file.
+func (d *assetHandler) fileETag(filename string, file io.ReadSeeker) (string, error) {
+	d.etagMutex.Lock()
+	tag, ok := d.etags[filename]
+	d.etagMutex.Unlock()
+	if ok {
+		return tag, nil
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("seeker can't seek")
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	tag = formatETag(hash.Sum(nil))
+
+	d.etagMutex.Lock()
+	defer d.etagMutex.Unlock()
+	if d.etags == nil {
+		d.etags = map[string]string{}
+	}
+	d.etags[filename] = tag
+	return tag, nil
+}
+
 func (d *assetHandler) logDebug(message string, args ...interface{}) {
 	if d.logger != nil {
 		d.logger.Debug("[AssetHandler] "+message, args...)
@@ -0,0 +1,63 @@
+//go:build dev
+// +build dev
+
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+func TestAssetHandlerETag(t *testing.T) {
+	assets := fstest.MapFS{
+		"index.html": {Data: []byte("<html><body></body></html>")},
+		"main.css":   {Data: []byte("body{margin:0}")},
+	}
+	handler, err := NewAssetHandler(assetserver.Options{Assets: assets}, nil)
+	if err != nil {
+		t.Fatalf("NewAssetHandler() error = '%v'", err)
+	}
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/main.css", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set(HeaderIfNoneMatch, ifNoneMatch)
+		}
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	first := get("")
+	tag := first.Header().Get(HeaderETag)
+	if first.Code != http.StatusOK || tag != etag(assets["main.css"].Data) {
+		t.Fatalf("first request = '%v' with ETag '%v', want '%v' with ETag '%v'", first.Code, tag, http.StatusOK, etag(assets["main.css"].Data))
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"cached", "", http.StatusOK},
+		{"fresh", tag, http.StatusNotModified},
+		{"fresh-weak", "W/" + tag, http.StatusNotModified},
+		{"wildcard", "*", http.StatusNotModified},
+		{"stale", `"0123"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rw := get(tt.ifNoneMatch)
+			if rw.Code != tt.want {
+				t.Errorf("status = '%v', want '%v'", rw.Code, tt.want)
+			}
+			if got := rw.Header().Get(HeaderETag); got != tag {
+				t.Errorf("ETag = '%v', want '%v'", got, tag)
+			}
+		})
+	}
+}
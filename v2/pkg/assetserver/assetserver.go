@@ -44,6 +44,9 @@ type AssetServer struct {
 	// Use http based runtime
 	runtimeHandler RuntimeHandler
 
+	// Modifies the index.html per request
+	indexTransform func(indexHTML []byte, req *http.Request) []byte
+
 	// plugin scripts
 	pluginScripts map[string]string
 
@@ -96,6 +99,12 @@ func (d *AssetServer) UseRuntimeHandler(handler RuntimeHandler) {
 	d.runtimeHandler = handler
 }
 
+// UseIndexTransform sets a function which is called with the processed index.html
+// for every request and returns the content to serve instead.
+func (d *AssetServer) UseIndexTransform(transform func(indexHTML []byte, req *http.Request) []byte) {
+	d.indexTransform = transform
+}
+
 func (d *AssetServer) AddPluginScript(pluginName string, script string) {
 	if d.pluginScripts == nil {
 		d.pluginScripts = make(map[string]string)
@@ -125,7 +134,7 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	path := req.URL.Path
 	if path == runtimeJSPath {
-		d.writeBlob(rw, path, d.runtimeJS)
+		d.writeBlob(rw, req, path, d.runtimeJS)
 	} else if path == runtimePath && d.runtimeHandler != nil {
 		d.runtimeHandler.HandleRuntimeCall(rw, req)
 	} else if path == ipcJSPath {
@@ -133,10 +142,10 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		if d.ipcJS != nil {
 			content = d.ipcJS(req)
 		}
-		d.writeBlob(rw, path, content)
+		d.writeBlob(rw, req, path, content)
 
 	} else if script, ok := d.pluginScripts[path]; ok {
-		d.writeBlob(rw, path, []byte(script))
+		d.writeBlob(rw, req, path, []byte(script))
 	} else if d.isRuntimeInjectionMatch(path) {
 		recorder := &bodyRecorder{
 			ResponseWriter: rw,
@@ -169,10 +178,13 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 				d.serveError(rw, err, "Unable to processIndexHTML")
 				return
 			}
-			d.writeBlob(rw, indexHTML, content)
+			if d.indexTransform != nil {
+				content = d.indexTransform(content, req)
+			}
+			d.writeBlob(rw, req, indexHTML, content)
 
 		case http.StatusNotFound:
-			d.writeBlob(rw, indexHTML, defaultHTML)
+			d.writeBlob(rw, req, indexHTML, defaultHTML)
 
 		default:
 			rw.WriteHeader(code)
@@ -220,8 +232,8 @@ func (d *AssetServer) processIndexHTML(indexHTML []byte) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-func (d *AssetServer) writeBlob(rw http.ResponseWriter, filename string, blob []byte) {
-	err := serveFile(rw, filename, blob)
+func (d *AssetServer) writeBlob(rw http.ResponseWriter, req *http.Request, filename string, blob []byte) {
+	err := serveFile(rw, req, filename, blob)
 	if err != nil {
 		d.serveError(rw, err, "Unable to write content %s", filename)
 	}
@@ -2,6 +2,8 @@ package assetserver
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"io"
 	"net/http"
@@ -38,12 +40,23 @@ const (
 	HeaderUserAgent     = "User-Agent"
 	HeaderCacheControl  = "Cache-Control"
 	HeaderUpgrade       = "Upgrade"
+	HeaderETag          = "ETag"
+	HeaderIfNoneMatch   = "If-None-Match"
 
 	WailsUserAgentValue = "wails.io"
 )
 
-func serveFile(rw http.ResponseWriter, filename string, blob []byte) error {
+func serveFile(rw http.ResponseWriter, req *http.Request, filename string, blob []byte) error {
 	header := rw.Header()
+	if etagsEnabled {
+		tag := etag(blob)
+		header.Set(HeaderETag, tag)
+		if etagMatches(req.Header.Get(HeaderIfNoneMatch), tag) {
+			rw.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
 	header.Set(HeaderContentLength, strconv.Itoa(len(blob)))
 	if mimeType := header.Get(HeaderContentType); mimeType == "" {
 		mimeType = GetMimetype(filename, blob)
@@ -55,6 +68,28 @@ func serveFile(rw http.ResponseWriter, filename string, blob []byte) error {
 	return err
 }
 
+// etag returns a strong ETag based on the hash of the content
+func etag(blob []byte) string {
+	hash := sha256.Sum256(blob)
+	return formatETag(hash[:])
+}
+
+// formatETag formats the SHA-256 hash of a content as an ETag
+func formatETag(hash []byte) string {
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+// etagMatches returns true if the If-None-Match header matches the ETag
+func etagMatches(ifNoneMatch string, tag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == tag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
 func createScriptNode(scriptName string) *html.Node {
 	return &html.Node{
 		Type: html.ElementNode,
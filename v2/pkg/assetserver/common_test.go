@@ -0,0 +1,74 @@
+//go:build dev
+// +build dev
+
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEtagMatches(t *testing.T) {
+	tag := etag([]byte("body{}"))
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"empty", "", false},
+		{"same", tag, true},
+		{"other", `"0123"`, false},
+		{"weak", "W/" + tag, true},
+		{"wildcard", "*", true},
+		{"list", `"0123", ` + tag, true},
+		{"list-weak", `"0123",W/` + tag, true},
+		{"list-other", `"0123", "4567"`, false},
+		{"unquoted", tag[1 : len(tag)-1], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := etagMatches(tt.ifNoneMatch, tag); got != tt.want {
+				t.Errorf("etagMatches('%v') = '%v', want '%v'", tt.ifNoneMatch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeFile(t *testing.T) {
+	blob := []byte("body{margin:0}")
+	tag := etag(blob)
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantStatus  int
+		wantBody    string
+	}{
+		{"no-validator", "", http.StatusOK, string(blob)},
+		{"stale", `"0123"`, http.StatusOK, string(blob)},
+		{"fresh", tag, http.StatusNotModified, ""},
+		{"fresh-weak", "W/" + tag, http.StatusNotModified, ""},
+		{"wildcard", "*", http.StatusNotModified, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/main.css", nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set(HeaderIfNoneMatch, tt.ifNoneMatch)
+			}
+			rw := httptest.NewRecorder()
+			if err := serveFile(rw, req, "main.css", blob); err != nil {
+				t.Fatalf("serveFile() error = '%v'", err)
+			}
+			if rw.Code != tt.wantStatus {
+				t.Errorf("serveFile() status = '%v', want '%v'", rw.Code, tt.wantStatus)
+			}
+			if got := rw.Header().Get(HeaderETag); got != tag {
+				t.Errorf("serveFile() ETag = '%v', want '%v'", got, tag)
+			}
+			if got := rw.Body.String(); got != tt.wantBody {
+				t.Errorf("serveFile() body = '%v', want '%v'", got, tt.wantBody)
+			}
+		})
+	}
+}
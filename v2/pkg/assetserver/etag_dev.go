@@ -0,0 +1,9 @@
+//go:build dev
+// +build dev
+
+package assetserver
+
+// etagsEnabled makes the asset server send ETags and answer conditional
+// requests with a 304, so browsers connected to the dev server revalidate the
+// assets instead of downloading them again
+const etagsEnabled = true
@@ -0,0 +1,8 @@
+//go:build !dev
+// +build !dev
+
+package assetserver
+
+// etagsEnabled is false outside of dev mode, where the webview is served from
+// memory and conditional requests don't save anything
+const etagsEnabled = false
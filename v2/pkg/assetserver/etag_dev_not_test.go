@@ -0,0 +1,50 @@
+//go:build !dev
+// +build !dev
+
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
+)
+
+// Outside of dev mode, responses carry no ETag and conditional requests get
+// the full content
+func TestNoETags(t *testing.T) {
+	blob := []byte("body{margin:0}")
+	handler, err := NewAssetHandler(assetserver.Options{Assets: fstest.MapFS{
+		"index.html": {Data: []byte("<html><body></body></html>")},
+		"main.css":   {Data: blob},
+	}}, nil)
+	if err != nil {
+		t.Fatalf("NewAssetHandler() error = '%v'", err)
+	}
+
+	tests := []struct {
+		name        string
+		serve       func(rw http.ResponseWriter, req *http.Request)
+		ifNoneMatch string
+	}{
+		{"serveFile", func(rw http.ResponseWriter, req *http.Request) { _ = serveFile(rw, req, "main.css", blob) }, etag(blob)},
+		{"serveFile-wildcard", func(rw http.ResponseWriter, req *http.Request) { _ = serveFile(rw, req, "main.css", blob) }, "*"},
+		{"assetHandler", handler.ServeHTTP, etag(blob)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/main.css", nil)
+			req.Header.Set(HeaderIfNoneMatch, tt.ifNoneMatch)
+			rw := httptest.NewRecorder()
+			tt.serve(rw, req)
+			if rw.Code != http.StatusOK || rw.Body.String() != string(blob) {
+				t.Errorf("response = '%v' '%v', want '%v' '%s'", rw.Code, rw.Body.String(), http.StatusOK, blob)
+			}
+			if got := rw.Header().Get(HeaderETag); got != "" {
+				t.Errorf("ETag = '%v', want none", got)
+			}
+		})
+	}
+}